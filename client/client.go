@@ -18,13 +18,14 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	powerscale "dell/powerscale-go-client"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/cookiejar"
 	"strconv"
@@ -47,6 +48,16 @@ var mutex sync.Mutex
 // AuthContextKey define own type for context key to avoid collisions between packages using context.
 type AuthContextKey string
 
+// Options holds the optional settings of the client.
+type Options struct {
+	// Retry is the policy for retrying API requests which failed with a transient error.
+	Retry RetryPolicy
+	// RateLimit limits the concurrency and the rate of the API requests.
+	RateLimit RateLimit
+	// TLS holds the settings used to verify the cluster certificate and authenticate the client.
+	TLS TLSOptions
+}
+
 // Client type is to hold powerscale client.
 type Client struct {
 	PscaleOpenAPIClient *powerscale.APIClient
@@ -96,6 +107,41 @@ func (c *Client) SetOnefsVersion(major, minor, patch int) {
 	c.onefsVersion = &OnefsVersion{major, minor, patch}
 }
 
+// SendJSONRequest sends a request with the JSON encoded body to a path of the cluster API, such as platform/1/worm/settings.
+// It is only used for request bodies which cannot be expressed with the generated client.
+func (c *Client) SendJSONRequest(ctx context.Context, method, path string, body interface{}) error {
+	cfg := c.PscaleOpenAPIClient.GetConfig()
+	host, err := cfg.ServerURLWithContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, method, concatUrl(host, path), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for key, value := range cfg.DefaultHeader {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("User-Agent", cfg.UserAgent)
+	resp, err := cfg.HTTPClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s %s failed with response code %d: %s", method, path, resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
 // OnefsVersion present OneFS release version.
 type OnefsVersion struct {
 	Major, Minor, Patch int
@@ -167,7 +213,7 @@ func (v OnefsVersion) String() string {
 // NewClient returns the client.
 func NewClient(endpoint string,
 	insecure bool,
-	user string, pass string, authType, timeout int64) (*Client, error) {
+	user string, pass string, authType, timeout int64, opts Options) (*Client, error) {
 	openAPIClient, err := NewOpenAPIClient(
 		context.Background(),
 		endpoint,
@@ -176,6 +222,7 @@ func NewClient(endpoint string,
 		pass,
 		authType,
 		timeout,
+		opts,
 	)
 	if err != nil {
 		return nil, err
@@ -189,7 +236,7 @@ func NewClient(endpoint string,
 }
 
 // NewOpenAPIClient returns the OpenApi Client.
-func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user string, pass string, authType int64, timeout int64) (*powerscale.APIClient, error) {
+func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user string, pass string, authType int64, timeout int64, opts Options) (*powerscale.APIClient, error) {
 	// Setup a User-Agent for your API client (replace the provider name for yours):
 	userAgent := "terraform-powerscale-provider/1.0.0"
 	jar, err := cookiejar.New(nil)
@@ -202,42 +249,26 @@ func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user
 		Jar:     jar,
 	}
 
-	var transport *http.Transport
-	if insecure {
-		transport = &http.Transport{
-			// This is done intentionally if the user sets the skipVerify to true
-			/* #nosec */
-			TLSClientConfig: &tls.Config{
-				MinVersion: tls.VersionTLS12,
-				/* #nosec */
-				InsecureSkipVerify: true,
-			},
-			MaxIdleConns:        100,
-			MaxIdleConnsPerHost: 30,
-			MaxConnsPerHost:     10,
-			IdleConnTimeout:     90 * time.Second,
-		}
-	} else {
-		// Loading system certs by default if insecure is set to false
-		// TODO: Check if we need to remove references to UseCerts from the code
-		pool, err := x509.SystemCertPool()
-		if err != nil {
-			errSysCerts := errors.New("unable to initialize cert pool from system")
-			return nil, errSysCerts
-		}
-		transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				MinVersion:         tls.VersionTLS12,
-				RootCAs:            pool,
-				InsecureSkipVerify: false,
-			},
-			MaxIdleConns:        100,
-			MaxIdleConnsPerHost: 30,
-			MaxConnsPerHost:     10,
-			IdleConnTimeout:     90 * time.Second,
-		}
+	tlsConfig, err := NewTLSConfig(insecure, opts.TLS)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 30,
+		MaxConnsPerHost:     10,
+		IdleConnTimeout:     90 * time.Second,
 	}
 
+	if opts.RateLimit.MaxConcurrentRequests > transport.MaxConnsPerHost {
+		transport.MaxConnsPerHost = opts.RateLimit.MaxConcurrentRequests
+	}
+
+	// every attempt of a request is rate limited, and transient failures are retried
+	// below the session handling, so that every attempt carries the current session
+	roundTripper := NewRetryTransport(NewRateLimitTransport(transport, opts.RateLimit), opts.Retry)
+
 	cfg := powerscale.Configuration{
 		HTTPClient:    httpclient,
 		DefaultHeader: make(map[string]string),
@@ -255,11 +286,11 @@ func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user
 	//fmt.Printf("config %+v header %+v\n", cfg, cfg.DefaultHeader)
 
 	if authType == BasicAuthType {
-		httpclient.Transport = transport
+		httpclient.Transport = roundTripper
 		basicAuth(user, pass, &cfg)
 	} else if authType == SessionAuthType {
 		ctx = context.WithValue(ctx, AuthContextKey(AuthType), SessionAuthType)
-		httpclient.Transport = &TokenTransport{Ctx: ctx, Username: user, Password: pass, RoundTripper: transport}
+		httpclient.Transport = &TokenTransport{Ctx: ctx, Username: user, Password: pass, RoundTripper: roundTripper}
 		err := sessionAuth(ctx, user, pass, &cfg)
 		if err != nil {
 			return nil, err
@@ -298,22 +329,25 @@ func sessionAuth(ctx context.Context, user string, pass string, cfg *powerscale.
 	if err != nil {
 		return err
 	}
-	resp, err := RequestSession(host, user, pass, cfg)
+	resp, err := RequestSession(ctx, host, user, pass, cfg)
 	if err != nil {
 		return err
 	}
 	if resp == nil {
 		return errors.New("authentication failed. empty response")
 	}
+	if resp.Body != nil {
+		// close the body on every path, it holds the connection and the rate limit slot of the request
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				tflog.Error(ctx, fmt.Sprintf("Error closing HTTP response: %s", err.Error()))
+			}
+		}()
+	}
 	tflog.Debug(ctx, fmt.Sprintf("response code: %d, response body: %s", resp.StatusCode, resp.Body))
 	if resp.Body == nil || resp.StatusCode != http.StatusCreated {
 		return fmt.Errorf("authentication failed. response code: %d", resp.StatusCode)
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			tflog.Error(ctx, fmt.Sprintf("Error closing HTTP response: %s", err.Error()))
-		}
-	}()
 	isisessid := getCookie(resp.Cookies(), "isisessid")
 	isicsrf := getCookie(resp.Cookies(), "isicsrf")
 	if len(isisessid) == 0 || len(isicsrf) == 0 {
@@ -334,11 +368,11 @@ func getCookie(cookies []*http.Cookie, cookieName string) string {
 	return ""
 }
 
-func RequestSession(host string, user string, pass string, cfg *powerscale.Configuration) (*http.Response, error) {
+func RequestSession(ctx context.Context, host string, user string, pass string, cfg *powerscale.Configuration) (*http.Response, error) {
 	sessionUrl := concatUrl(host, SessionEndpoint)
 	json := fmt.Sprintf(`{"username":"%s", "password":"%s", "services":["platform", "namespace"]}`, user, pass)
 
-	request, err := http.NewRequest("POST", sessionUrl, strings.NewReader(json))
+	request, err := http.NewRequestWithContext(ctx, "POST", sessionUrl, strings.NewReader(json))
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +397,7 @@ type TokenTransport struct {
 
 func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	resp, err := t.RoundTripper.RoundTrip(req)
-	if t.Ctx.Value(AuthContextKey(AuthType)) != SessionAuthType || req.URL.Path == SessionEndpoint {
+	if t.Ctx.Value(AuthContextKey(AuthType)) != SessionAuthType || strings.TrimPrefix(req.URL.Path, "/") == SessionEndpoint {
 		return resp, err
 	}
 	if err != nil {
@@ -373,12 +407,25 @@ func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, fmt.Errorf("got empty response for request [%s]", req.URL.Path)
 	}
 	if resp.StatusCode == http.StatusUnauthorized {
+		// release the connection and the rate limit slot of the rejected request before re-authenticating,
+		// otherwise the session request may wait forever for a free slot
+		if resp.Body != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
 		config := t.Client.GetConfig()
-		err := sessionAuth(t.Ctx, t.Username, t.Password, config)
+		err := sessionAuth(req.Context(), t.Username, t.Password, config)
 		if err != nil {
 			return nil, err
 		}
 		newReq := req.Clone(req.Context()) // per RoundTrip contract
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			newReq.Body = body
+		}
 		for key, value := range config.DefaultHeader {
 			newReq.Header.Set(key, value)
 		}
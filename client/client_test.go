@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSessionTestClient returns an HTTP client authenticating with a session against the server,
+// whose requests go through a rate limit transport allowing a single request in flight.
+func newSessionTestClient(server *httptest.Server, password string) (*http.Client, *powerscale.Configuration) {
+	ctx := context.WithValue(context.Background(), AuthContextKey(AuthType), SessionAuthType)
+	roundTripper := NewRateLimitTransport(http.DefaultTransport, RateLimit{MaxConcurrentRequests: 1})
+	transport := &TokenTransport{Ctx: ctx, Username: "admin", Password: password, RoundTripper: roundTripper}
+	httpClient := &http.Client{Transport: transport}
+	cfg := &powerscale.Configuration{
+		HTTPClient:    httpClient,
+		DefaultHeader: make(map[string]string),
+		Servers: powerscale.ServerConfigurations{
+			powerscale.ServerConfiguration{URL: server.URL},
+		},
+		OperationServers: map[string]powerscale.ServerConfigurations{},
+	}
+	transport.Client = powerscale.NewAPIClient(cfg)
+	return httpClient, cfg
+}
+
+func TestSessionRefreshWithConcurrencyLimit(t *testing.T) {
+	var sessions int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimPrefix(r.URL.Path, "/") == SessionEndpoint {
+			body, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(body), `"password":"wrong"`) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"message":"invalid credentials"}`))
+				return
+			}
+			atomic.AddInt32(&sessions, 1)
+			http.SetCookie(w, &http.Cookie{Name: "isisessid", Value: "session"})
+			http.SetCookie(w, &http.Cookie{Name: "isicsrf", Value: "csrf"})
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		// the session has expired until the client re-authenticates
+		if !strings.Contains(r.Header.Get("Cookie"), "isisessid=session") {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"session expired"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the 401 response releases its slot, so the session refresh and the retried request do not hang
+	httpClient, _ := newSessionTestClient(server, "password")
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/platform/1/cluster/config", nil)
+		resp, err := httpClient.Do(req)
+		assert.Nil(t, err)
+		if err == nil {
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			resp.Body.Close()
+		}
+	}
+	// the requests do not carry the session cookie, so each one is rejected and refreshes the session
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sessions))
+
+	// a rejected session request releases its slot as well
+	_, cfg := newSessionTestClient(server, "wrong")
+	for i := 0; i < 2; i++ {
+		err := sessionAuth(ctx, "admin", "wrong", cfg)
+		assert.ErrorContains(t, err, "response code: 401")
+	}
+	assert.Nil(t, ctx.Err())
+}
+
+func TestSendJSONRequest(t *testing.T) {
+	var method, path, contentType, body string
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		method, path, contentType, body = r.Method, r.URL.Path, r.Header.Get("Content-Type"), string(data)
+		w.WriteHeader(status)
+		if status != http.StatusNoContent {
+			_, _ = w.Write([]byte(`{"errors":[{"message":"invalid value"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	cfg := &powerscale.Configuration{
+		HTTPClient:    &http.Client{},
+		DefaultHeader: getHeaders(),
+		Servers: powerscale.ServerConfigurations{
+			powerscale.ServerConfiguration{URL: server.URL},
+		},
+		OperationServers: map[string]powerscale.ServerConfigurations{},
+	}
+	c := &Client{PscaleOpenAPIClient: powerscale.NewAPIClient(cfg)}
+
+	err := c.SendJSONRequest(context.Background(), http.MethodPut, "platform/1/worm/settings", map[string]interface{}{"cdate": map[string]interface{}{}})
+	assert.Nil(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/platform/1/worm/settings", path)
+	assert.Equal(t, "application/json; charset=utf-8", contentType)
+	assert.JSONEq(t, `{"cdate":{}}`, body)
+
+	status = http.StatusBadRequest
+	err = c.SendJSONRequest(context.Background(), http.MethodPut, "platform/1/worm/settings", map[string]interface{}{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "response code 400")
+	assert.Contains(t, err.Error(), "invalid value")
+}
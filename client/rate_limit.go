@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit configures the client-side limits of the API requests sent to the cluster.
+type RateLimit struct {
+	// MaxConcurrentRequests is the maximum number of requests in flight at the same time. 0 means unlimited.
+	MaxConcurrentRequests int
+	// RequestsPerSecond is the sustained rate of requests. 0 means unlimited.
+	RequestsPerSecond float64
+}
+
+// RateLimitTransport caps the number of requests in flight and the rate at which requests are sent.
+// A single transport is shared by all the resources and data sources of a provider instance.
+type RateLimitTransport struct {
+	http.RoundTripper
+	slots  chan struct{}
+	bucket *tokenBucket
+}
+
+// NewRateLimitTransport wraps the round tripper in a RateLimitTransport, or returns it as is when no limit is set.
+func NewRateLimitTransport(rt http.RoundTripper, limit RateLimit) http.RoundTripper {
+	if limit.MaxConcurrentRequests <= 0 && limit.RequestsPerSecond <= 0 {
+		return rt
+	}
+	t := &RateLimitTransport{RoundTripper: rt}
+	if limit.MaxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, limit.MaxConcurrentRequests)
+	}
+	if limit.RequestsPerSecond > 0 {
+		t.bucket = newTokenBucket(limit.RequestsPerSecond)
+	}
+	return t
+}
+
+// RoundTrip waits for a free slot and a token before sending the request.
+// The slot is held until the response body is closed.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	}
+
+	if t.bucket != nil {
+		if err := t.bucket.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.RoundTripper.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnClose releases the concurrency slot of a request once its response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close closes the response body and releases the slot.
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+// tokenBucket hands out tokens at a fixed rate, allowing bursts of up to one second worth of tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full token bucket refilled at the given rate per second.
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Floor(rate))
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// wait takes a token from the bucket, waiting until one is available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	// reserve the token right away, so that concurrent callers are served in order
+	b.tokens--
+	tokens := b.tokens
+	b.mu.Unlock()
+
+	if tokens >= 0 {
+		return nil
+	}
+	delay := time.Duration(-tokens / b.rate * float64(time.Second))
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		// give back the reserved token
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
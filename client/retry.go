@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryStatusCodes are the HTTP status codes which are retried when no status codes are configured.
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures the automatic retry of failed API requests.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry. The wait doubles with every further retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.
+	MaxBackoff time.Duration
+	// StatusCodes are the HTTP status codes which are retried.
+	StatusCodes []int
+}
+
+// RetryTransport retries requests which failed with a transient error, waiting with exponential backoff and jitter between attempts.
+// Idempotent requests are retried on connection errors and on any of the retryable status codes.
+// Other requests, such as POST, may already have been applied by the cluster,
+// so they are only retried when the cluster rejected them with 429 or 503 before processing them.
+type RetryTransport struct {
+	http.RoundTripper
+	Policy RetryPolicy
+}
+
+// NewRetryTransport wraps the round tripper in a RetryTransport, or returns it as is when retries are disabled.
+func NewRetryTransport(rt http.RoundTripper, policy RetryPolicy) http.RoundTripper {
+	if policy.MaxAttempts <= 1 {
+		return rt
+	}
+	if len(policy.StatusCodes) == 0 {
+		policy.StatusCodes = DefaultRetryStatusCodes
+	}
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = time.Second
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = policy.MinBackoff
+	}
+	return &RetryTransport{RoundTripper: rt, Policy: policy}
+}
+
+// RoundTrip executes the request, retrying it according to the retry policy.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := t.RoundTripper.RoundTrip(req)
+		if attempt >= t.Policy.MaxAttempts || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		retryReq, rerr := rewindRequest(req)
+		if rerr != nil {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			// drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		req = retryReq
+	}
+}
+
+// shouldRetry returns whether the outcome of an attempt is a transient failure which can be retried.
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	idempotent := isIdempotent(req.Method)
+	if err != nil {
+		return idempotent
+	}
+	if !slices.Contains(t.Policy.StatusCodes, resp.StatusCode) {
+		return false
+	}
+	return idempotent || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// backoff returns the wait before the next attempt.
+// A Retry-After header sent by the cluster takes precedence over the exponential backoff, capped at the maximum backoff.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, t.Policy.MaxBackoff)
+		}
+	}
+	wait := t.Policy.MinBackoff
+	for i := 1; i < attempt && wait < t.Policy.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, t.Policy.MaxBackoff)
+	// equal jitter: wait at least half of the backoff so that retries are spread out but still back off
+	half := wait / 2
+	/* #nosec */
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent returns whether requests with the method can safely be sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// rewindRequest returns a copy of the request with a fresh body, so that it can be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("request body of [%s] cannot be replayed", req.URL.Path)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	newReq := req.Clone(req.Context()) // per RoundTrip contract
+	newReq.Body = body
+	return newReq, nil
+}
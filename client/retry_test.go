@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	attempts := 0
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{
+		Transport: NewRetryTransport(http.DefaultTransport, RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  10 * time.Millisecond,
+			MaxBackoff:  50 * time.Millisecond,
+		}),
+	}
+
+	// idempotent requests are retried on retryable status codes
+	status = http.StatusBadGateway
+	resp, err := httpClient.Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+
+	// non idempotent requests are not retried when the cluster may have processed them
+	attempts = 0
+	resp, err = httpClient.Post(server.URL, "application/json", strings.NewReader("{}"))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+
+	// non idempotent requests are retried with their body when rejected by the cluster
+	attempts = 0
+	status = http.StatusServiceUnavailable
+	resp, err = httpClient.Post(server.URL, "application/json", strings.NewReader("{}"))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TLSOptions holds the TLS settings used to verify the cluster and authenticate the client.
+type TLSOptions struct {
+	// CACertificates is a PEM bundle of CA certificates trusted in addition to the system ones.
+	CACertificates []byte
+	// ServerName overrides the host name used for SNI and for verifying the cluster certificate.
+	ServerName string
+	// CertificateFingerprints are the SHA-256 fingerprints of which one must match a certificate presented by the cluster.
+	CertificateFingerprints []string
+	// ClientCertificate and ClientKey are the PEM encoded certificate and key presented to the cluster.
+	ClientCertificate []byte
+	ClientKey         []byte
+}
+
+// NewTLSConfig returns the TLS config used to connect to the cluster.
+func NewTLSConfig(insecure bool, opts TLSOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: opts.ServerName,
+	}
+
+	if insecure {
+		// This is done intentionally if the user sets the skipVerify to true
+		/* #nosec */
+		tlsConfig.InsecureSkipVerify = true
+	} else {
+		// Loading system certs by default if insecure is set to false
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, errors.New("unable to initialize cert pool from system")
+		}
+		if len(opts.CACertificates) > 0 && !pool.AppendCertsFromPEM(opts.CACertificates) {
+			return nil, errors.New("no valid certificate found in the CA certificate bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(opts.ClientCertificate) > 0 || len(opts.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(opts.ClientCertificate, opts.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(opts.CertificateFingerprints) > 0 {
+		pinned := make(map[string]bool, len(opts.CertificateFingerprints))
+		for _, fingerprint := range opts.CertificateFingerprints {
+			pinned[NormalizeFingerprint(fingerprint)] = true
+		}
+		// VerifyConnection runs even when the chain verification is skipped, so pinning also works with insecure
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			for _, cert := range state.PeerCertificates {
+				sum := sha256.Sum256(cert.Raw)
+				if pinned[hex.EncodeToString(sum[:])] {
+					return nil
+				}
+			}
+			return errors.New("no certificate presented by the cluster matches the pinned certificate fingerprints")
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// NormalizeFingerprint returns the fingerprint in lower case hex, without separators.
+func NormalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+}
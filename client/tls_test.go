@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caCertificates := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	sum := sha256.Sum256(server.Certificate().Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+
+	get := func(insecure bool, opts TLSOptions) error {
+		tlsConfig, err := NewTLSConfig(insecure, opts)
+		if err != nil {
+			return err
+		}
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		resp, err := httpClient.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	// the cluster certificate is verified against the CA bundle
+	assert.NotNil(t, get(false, TLSOptions{}))
+	assert.Nil(t, get(false, TLSOptions{CACertificates: caCertificates}))
+	assert.NotNil(t, get(false, TLSOptions{CACertificates: []byte("invalid")}))
+
+	// the server name overrides the host of the endpoint
+	assert.Nil(t, get(false, TLSOptions{CACertificates: caCertificates, ServerName: "example.com"}))
+	assert.NotNil(t, get(false, TLSOptions{CACertificates: caCertificates, ServerName: "invalid.com"}))
+
+	// the pinned fingerprints are enforced even when the chain is not verified
+	assert.Nil(t, get(true, TLSOptions{CertificateFingerprints: []string{strings.ToUpper(fingerprint)}}))
+	assert.NotNil(t, get(true, TLSOptions{CertificateFingerprints: []string{strings.Repeat("00", 32)}}))
+	assert.Equal(t, "abcd01", NormalizeFingerprint(" AB:CD:01 "))
+}
@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale ADS provider trusted domains data source allows you to list the trusted domains of an ADS provider.
+
+# Returns the trusted domains of the ADS provider
+data "powerscale_adsprovider_trusted_domain" "example" {
+  # Required, the ID of the ADS provider.
+  ads_provider_id = "ADS.PROVIDER.EXAMPLE.COM"
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_adsprovider_trusted_domain.example
+output "powerscale_adsprovider_trusted_domain" {
+  value = data.powerscale_adsprovider_trusted_domain.example.trusted_domains
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
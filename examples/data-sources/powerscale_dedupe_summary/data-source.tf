@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale dedupe summary data source allows you to get the space savings of SmartDedupe.
+
+data "powerscale_dedupe_summary" "example" {
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_dedupe_summary.example
+output "powerscale_dedupe_summary" {
+  value = data.powerscale_dedupe_summary.example
+}
+
+# The saved bytes can be computed from the saved logical blocks and the block size.
+output "powerscale_dedupe_saved_bytes" {
+  value = data.powerscale_dedupe_summary.example.summary.saved_logical_blocks * data.powerscale_dedupe_summary.example.summary.block_size
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale File data source allows you to get the metadata and optionally the content of a file.
+
+# Returns the metadata of the file
+data "powerscale_file" "metadata" {
+  path = "/ifs/data/example/config.txt"
+}
+
+# Returns the metadata and the content of the file
+data "powerscale_file" "content" {
+  path            = "/ifs/data/example/config.txt"
+  include_content = true
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_file.content
+output "powerscale_file" {
+  value = data.powerscale_file.content
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale file providers data source allows you to list the file providers with their settings.
+
+# Returns all the file providers
+data "powerscale_file_provider" "all" {
+}
+
+# Returns the file providers with the given names
+data "powerscale_file_provider" "example" {
+  filter {
+    # Optional, the names of the file providers.
+    names = ["file_provider"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_file_provider.all
+output "powerscale_file_provider" {
+  value = data.powerscale_file_provider.all
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
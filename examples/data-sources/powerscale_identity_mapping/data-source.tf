@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This Terraform DataSource is used to look up the identity mappings of a persona on PowerScale array.
+
+# Returns the targets the SID is mapped to in the System zone
+data "powerscale_identity_mapping" "sid" {
+  source = "SID:S-1-5-21-1111111111-2222222222-3333333333-1001"
+}
+
+# Returns the targets the UID is mapped to in the specified access zone
+data "powerscale_identity_mapping" "uid" {
+  source = "UID:200001"
+  zone   = "System"
+}
+
+# Output value of above block by executing 'terraform output' command
+# You can use the the fetched information by the variable data.powerscale_identity_mapping.sid
+output "powerscale_identity_mapping_sid" {
+  value = data.powerscale_identity_mapping.sid
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale Job data source allows you to get a list of the active, that is running and paused, Job Engine jobs.
+# The recently finished jobs recorded in the job events can be listed as well.
+
+# Returns a list of PowerScale Jobs
+data "powerscale_job" "all" {
+}
+
+# Returns the running PowerScale Jobs of the given types
+data "powerscale_job" "running" {
+  filter {
+    state = "running"
+    types = ["TreeDelete", "SmartPools"]
+    sort  = "id"
+    dir   = "DESC"
+    limit = 10
+  }
+}
+
+# Returns the active PowerScale Jobs followed by the most recently finished ones, 20 jobs at most
+data "powerscale_job" "recent" {
+  filter {
+    include_finished = true
+    limit            = 20
+  }
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_job.running.jobs
+output "powerscale_jobs" {
+  value = data.powerscale_job.running.jobs
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
@@ -36,5 +36,26 @@ output "powerscale_snapshot" {
   value = data.powerscale_snapshot.test
 }
 
+# Returns a subset of the PowerScale snapshots based on the server-side filters provided in the filter block
+data "powerscale_snapshot" "latest_scheduled" {
+  filter {
+    # Optional name of the schedule which created the snapshots
+    schedule = "daily_schedule"
+    # Optional state of the snapshots. Accepted values: all, active, deleting
+    state = "active"
+    # Optional type of the snapshots. Accepted values: all, alias, real
+    type = "real"
+    # Optional sorting of the snapshots
+    sort = "created"
+    dir  = "DESC"
+    # Optional maximum number of snapshots to return. If not set, all the snapshots are fetched.
+    limit = 10
+  }
+}
+
+output "powerscale_snapshot_latest_scheduled" {
+  value = data.powerscale_snapshot.latest_scheduled
+}
+
 # After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
 # Also, we can use the fetched information by the variable data.powerscale_snapshot.all
\ No newline at end of file
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale storage pool data source allows you to list the node pools and tiers with their usage.
+
+# Returns all the storage pools
+data "powerscale_storagepool" "all" {
+}
+
+# Returns the storage pools matching the filter
+data "powerscale_storagepool" "tiers" {
+  filter {
+    # Optional, the names of the storage pools.
+    # names = ["archive_tier"]
+    # Optional, the type of the storage pools. Acceptable values: nodepool, tier.
+    type = "tier"
+  }
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_storagepool.all
+output "powerscale_storagepool" {
+  value = data.powerscale_storagepool.all
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
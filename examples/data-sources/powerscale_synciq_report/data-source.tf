@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale SyncIQ Report allows you to get a list of the reports of the SyncIQ jobs run on this cluster as the source.
+
+# Returns a list of PowerScale SyncIQ Reports
+data "powerscale_synciq_report" "all" {
+}
+
+# Returns the most recent PowerScale SyncIQ Reports of a policy in the given state
+data "powerscale_synciq_report" "latest" {
+  filter {
+    policy_name = "policy_name"
+    state       = "finished"
+    newer_than  = 7
+    sort        = "end_time"
+    dir         = "DESC"
+    limit       = 5
+  }
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_synciq_report.latest.reports
+output "powerscale_synciq_reports" {
+  value = data.powerscale_synciq_report.latest.reports
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
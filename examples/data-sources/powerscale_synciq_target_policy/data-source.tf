@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# PowerScale SyncIQ Target Policy allows you to get a list of the SyncIQ policies which replicate to this cluster.
+# This datasource should be used against the target cluster of the SyncIQ policies.
+
+# Returns a list of PowerScale SyncIQ Target Policies
+data "powerscale_synciq_target_policy" "all" {
+}
+
+# Returns the PowerScale SyncIQ Target Policies matching the given filters
+data "powerscale_synciq_target_policy" "failed_over" {
+  filter {
+    names                   = ["policy_name"]
+    target_path             = "/ifs/data/target"
+    last_job_state          = "finished"
+    failover_failback_state = "writes_enabled"
+  }
+}
+
+# Output value of above block by executing 'terraform output' command.
+# The user can use the fetched information by the variable data.powerscale_synciq_target_policy.all.target_policies
+output "powerscale_synciq_target_policies" {
+  value = data.powerscale_synciq_target_policy.all.target_policies
+}
+
+# The failover state of the target policies can be asserted, for example with a check block.
+check "failover_state" {
+  assert {
+    condition     = alltrue([for p in data.powerscale_synciq_target_policy.all.target_policies : p.failover_failback_state == "writes_disabled"])
+    error_message = "Some SyncIQ target policies are failed over."
+  }
+}
+
+# After the successful execution of above said block, We can see the output value by executing 'terraform output' command.
@@ -31,6 +31,54 @@ provider "powerscale" {
   insecure = var.insecure
 }
 
+# Retry API requests which failed with a transient error, such as during a SmartConnect node reboot.
+provider "powerscale" {
+  alias    = "retryProvider"
+  username = var.username
+  password = var.password
+  endpoint = var.endpoint
+  insecure = var.insecure
+
+  # Maximum number of attempts of a request, including the first attempt. Defaults to 1, which disables retries.
+  retry_max_attempts = 5
+  # Wait in seconds before the first retry, doubled with every further retry. Defaults to 1.
+  retry_min_backoff = 2
+  # Maximum wait in seconds between two attempts. Defaults to 30.
+  retry_max_backoff = 60
+  # Status codes of responses which are retried. Defaults to 429, 502, 503 and 504.
+  retry_status_codes = [429, 502, 503, 504]
+}
+
+# Limit the load put on the cluster by all the resources and data sources of a provider.
+provider "powerscale" {
+  alias    = "rateLimitedProvider"
+  username = var.username
+  password = var.password
+  endpoint = var.endpoint
+  insecure = var.insecure
+
+  # Maximum number of API requests in flight at the same time. Not limited by default.
+  max_concurrent_requests = 5
+  # Maximum rate of API requests per second, retries included. Not limited by default.
+  requests_per_second = 10
+}
+
+# Verify the cluster certificate against an internal CA instead of skipping the verification.
+provider "powerscale" {
+  alias    = "tlsProvider"
+  username = var.username
+  password = var.password
+  endpoint = var.endpoint
+  insecure = false
+
+  # PEM file with the CAs trusted in addition to the system ones. Use 'ca_certificate' to pass the PEM content inline.
+  ca_certificate_file = "/path/to/ca.pem"
+  # Server name verified against the cluster certificate, when it differs from the host of the endpoint.
+  tls_server_name = "cluster.example.com"
+  # SHA-256 fingerprints, of which one must match a certificate presented by the cluster.
+  certificate_fingerprints = ["AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89"]
+}
+
 # Specify 'alias' value in provider section to use multiple providers.
 provider "powerscale" {
   alias    = "secondProvider"
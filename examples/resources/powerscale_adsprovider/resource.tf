@@ -45,6 +45,10 @@ resource "powerscale_adsprovider" "ads_test" {
   #   reset_schannel = true
   #   spns = ["testSPN"]
 
+  #   Optional SPN reconciliation on every apply, cannot be used with spns in fix mode
+  #   "check" reports the missing SPNs as warnings, "fix" adds the missing SPNs
+  #   spns_reconcile_mode = "fix"
+
   #   Optional fields both for creating and updating
   #   allocate_gids = true
   #   allocate_uids = true
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load Auth Global Settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load Auth Global Settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting Auth Global Settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale Auth Global Settings control identity allocation, on-disk identity, NTLM and RPC behaviour of the authentication service.
+resource "powerscale_auth_global_settings" "example" {
+  # Optional, the access zone of the settings. Defaults to the System zone.
+  # zone = "System"
+
+  # Optional fields both for creating and updating
+  # Please check the acceptable inputs for each setting in the documentation
+  #     alloc_retries           = 5
+  #     cache_cred_lifetime     = 900
+  #     cache_id_lifetime       = 900
+  #     failed_login_delay_time = 4
+  #     on_disk_identity        = "native"
+  #     rpc_block_time          = 5
+  #     rpc_max_requests        = 64
+  #     rpc_timeout             = 30
+  #     send_ntlmv2             = false
+  #     space_replacement       = " "
+  #     unknown_gid             = 4294967294
+  #     unknown_uid             = 4294967294
+  #     workgroup               = "WORKGROUP"
+}
+
+# After the execution of above resource block, Auth Global Settings would have been cached in terraform state file, or
+# Auth Global Settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load Auth Mapping Settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load Auth Mapping Settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting Auth Mapping Settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale Auth Mapping Settings control the UID and GID ranges used by the ID mapper when allocating identities.
+resource "powerscale_auth_mapping_settings" "example" {
+  # Optional, the access zone of the settings. Defaults to the System zone.
+  # zone = "System"
+
+  # Optional fields both for creating and updating
+  # Please check the acceptable inputs for each setting in the documentation
+  #     gid_range_enabled  = true
+  #     gid_range_min      = 1000000
+  #     gid_range_max      = 2000000
+  #     gid_range_next_gid = 1000000
+  #     uid_range_enabled  = true
+  #     uid_range_min      = 1000000
+  #     uid_range_max      = 2000000
+  #     uid_range_next_uid = 1000000
+}
+
+# After the execution of above resource block, Auth Mapping Settings would have been cached in terraform state file, or
+# Auth Mapping Settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale CloudPools account holds the endpoint and the credentials of a cloud storage.
+resource "powerscale_cloudpools_account" "example" {
+  # Required, the account name.
+  name = "s3_account"
+
+  # Required, the type of cloud protocol, such as s3, ecs, azure, google or isilon. Updating this value recreates the account.
+  type = "s3"
+
+  # Required, the URI of the cloud storage endpoint.
+  uri = "https://s3.example.com:9000"
+
+  # Required, the username of the cloud account, such as the access key of an S3 account.
+  account_username = var.s3_access_key
+
+  # Required, the secret key of the cloud account.
+  key = var.s3_secret_key
+
+  # Optional, the region of the cloud storage.
+  storage_region = "us-east-1"
+
+  # Optional, whether the validation of the SSL certificate of the endpoint is skipped.
+  skip_ssl_validation = false
+
+  # Optional, whether the account is enabled.
+  enabled = true
+}
+
+# After the execution of above resource block, the CloudPools account would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale CloudPools pool groups CloudPools accounts.
+resource "powerscale_cloudpools_pool" "example" {
+  # Required, the pool name.
+  name = "s3_pool"
+
+  # Required, the type of cloud protocol, which must match the type of the accounts. Updating this value recreates the pool.
+  type = "s3"
+
+  # Required, the names of the CloudPools accounts in the pool.
+  accounts = [powerscale_cloudpools_account.example.name]
+
+  # Optional, the description of the pool.
+  description = "Cold data"
+}
+
+# The pool can be used as the cloud pool target of the file pool policies.
+resource "powerscale_filepool_policy" "archive" {
+  name = "cloud_archive_policy"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator = ">"
+            type     = "accessed_time"
+            value    = "180"
+            units    = "days"
+          }
+        ]
+      }
+    ]
+  }
+  actions = [
+    {
+      action_type = "set_cloudpool_policy"
+      cloudpool_policy_action = {
+        pool = powerscale_cloudpools_pool.example.name
+      }
+    }
+  ]
+}
+
+# After the execution of above resource block, the CloudPools pool would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
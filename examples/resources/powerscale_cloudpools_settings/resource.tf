@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# Deleting the resource only removes it from the state.
+
+# PowerScale CloudPools settings provide the default values of the cloud policies of the file pool policies.
+resource "powerscale_cloudpools_settings" "example" {
+  # Optional, specifies if files with snapshots should be archived by default.
+  archive_snapshot_files = true
+
+  # Optional, specifies the default cache expiration.
+  cache_expiration = 86400
+
+  # Optional, specifies the default cache read ahead type. Acceptable values: partial, full.
+  cache_read_ahead = "partial"
+
+  # Optional, specifies the default cache type. Acceptable values: cached, no-cache.
+  cache_type = "cached"
+
+  # Optional, specifies if files should be compressed by default.
+  compression = true
+
+  # Optional, specifies if files should be encrypted by default.
+  encryption = true
+
+  # Optional, specifies the default minimum amount of time archived data will be retained in the cloud after deletion.
+  data_retention = 604800
+
+  # Optional, the default minimum amount of time to wait before updating cloud data with local changes.
+  writeback_frequency = 32400
+}
+
+# After the execution of above resource block, the CloudPools settings would have been updated on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# Deleting the resource only removes it from the state.
+
+# PowerScale dedupe settings configure which paths SmartDedupe deduplicates and assesses.
+resource "powerscale_dedupe_settings" "example" {
+  # Optional, the paths that will be deduplicated.
+  paths = ["/ifs/data/dedupe"]
+
+  # Optional, the paths that will be assessed for the space savings of deduplication.
+  assess_paths = ["/ifs/data/assess"]
+
+  # Optional, enables or disables the Dedupe job.
+  dedupe_enabled = true
+}
+
+# After the execution of above resource block, the dedupe settings would have been updated on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# Writes a small file with inline content. A change of the content rewrites the whole file.
+resource "powerscale_file" "example" {
+  # Required, the absolute path of the file
+  path = "/ifs/data/example/config.txt"
+
+  # Exactly one of content, content_base64 and source is required
+  content = "hello world\n"
+  # content_base64 = filebase64("files/logo.png")
+  # source         = "files/config.txt"
+
+  # Optional fields
+  # Replaces a file which already exists at the path when creating the resource
+  overwrite = false
+  # POSIX mode such as 0644, or one of private_read, private, public_read, public_read_write and public when creating the file
+  access_control = "0644"
+  owner          = "admin"
+  group          = "Isilon Users"
+}
+
+# After the execution of above resource block, the file would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
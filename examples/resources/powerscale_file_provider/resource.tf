@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale file provider authenticates users and groups from passwd, group and netgroup files.
+resource "powerscale_file_provider" "example" {
+  # Required, the file provider name. Updating this value recreates the resource.
+  name = "file_provider"
+
+  # Optional, the files of the users, groups and netgroups.
+  password_file = "/ifs/data/passwd"
+  group_file    = "/ifs/data/group"
+  netgroup_file = "/ifs/data/netgroup"
+
+  # Optional, whether the password and group files can be modified through the provider.
+  modifiable_password_file = true
+  modifiable_group_file    = true
+
+  # Optional, the other settings of the provider.
+  enabled                 = true
+  authentication          = true
+  home_directory_template = "/ifs/home/%U"
+  login_shell             = "/bin/zsh"
+}
+
+# After the execution of above resource block, the file provider would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Delete.
+# Any change of the arguments copies the source again. Destroying the resource leaves the copy on the PowerScale array.
+# As the previous copy is left on the target, changing the arguments while keeping target_path requires overwrite, or merge for a directory.
+
+# Copies a directory, merging it into an existing target directory
+resource "powerscale_file_system_copy" "directory" {
+  # Required
+  source_path = "/ifs/data/tenant_a"
+  target_path = "/ifs/data/tenant_b"
+
+  # Optional fields, default to false
+  # Merges the source directory into the target directory when it already exists. Only supported for directories.
+  merge = true
+  # Overwrites the files which already exist in the target
+  overwrite = false
+  # Keeps copying the remaining files after a file could not be copied. Only supported for directories.
+  continue_on_error = false
+}
+
+# Clones a file from a snapshot, sharing its blocks instead of copying its data
+resource "powerscale_file_system_copy" "file" {
+  source_path = "/ifs/data/tenant_a/config.txt"
+  target_path = "/ifs/data/tenant_a/config.txt.bak"
+  clone       = true
+  # Optional, the snapshot to clone the file from. Only supported when clone is true.
+  # snapshot = "tenant_a_daily"
+}
+
+# After the execution of above resource block, the copy would have been made on the PowerScale array.
+# For more information, Please check the terraform state file.
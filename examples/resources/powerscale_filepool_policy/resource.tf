@@ -166,7 +166,8 @@ resource "powerscale_filepool_policy" "example_filepool_policy" {
     #     full_backup_retention = 145152000
     #     # The minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup. (Used with SyncIQ and NDMP backups.)
     #     incremental_backup_retention = 145152000
-    #     pool = "cloudPool_policy"
+    #     # Specifies the cloudPool storage target. Set it to the name of a powerscale_cloudpools_pool resource to create the pool before the policy.
+    #     pool = powerscale_cloudpools_pool.example.name
     #     # The minimum amount of time to wait before updating cloud data with local changes.
     #     writeback_frequency = 32400
     #   },
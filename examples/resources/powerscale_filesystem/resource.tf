@@ -15,7 +15,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-# Available actions: Create, Update (owner, group, access_control), Delete and Import existing FileSystem(Namespace directory) from Powerscale array.
+# Available actions: Create, Update (name, directory_path, owner, group, access_control), Delete and Import existing FileSystem(Namespace directory) from Powerscale array.
 # After `terraform apply` of this example file it will create a new FileSystem(Namespace directory) with the name set in `name` attribute in the directory path provided in `directory_path`on the PowerScale array
 
 # PowerScale FileSystem Resource allows you to manage the Namespace Directory on the Powerscale array
@@ -24,6 +24,7 @@ resource "powerscale_filesystem" "file_system_test" {
   # directory_path         = "/ifs"
 
   # Required attributes
+  # Changing name or directory_path moves the directory in place, keeping its content. The parent directory must exist.
   name = "DirTf"
   group = {
     id   = "GID:0"
@@ -45,6 +46,14 @@ resource "powerscale_filesystem" "file_system_test" {
   recursive = true
   # Deletes and replaces the existing user attributes and ACLs of the directory with user-specified attributes and ACLS, when set to true.
   overwrite = false
+  # Optional directory attributes, set through the namespace metadata.
+  # requested_protection = "+3:1"
+  # access_pattern       = "streaming"
+  # ssd_strategy         = "metadata"
+  # disk_pool_policy     = "anywhere"
+  # What to do with the directory on destroy: fail_if_not_empty, recursive (deletes the content too) or retain (leaves it on the array).
+  # The directory is never deleted while a quota, SMB share, NFS export or SyncIQ policy is set on it or below it.
+  delete_mode = "fail_if_not_empty"
 
 
   /* Optional : The ACL value for the directory. Users can either provide access rights input such as 'private_read' , 'private' ,
@@ -53,5 +62,12 @@ resource "powerscale_filesystem" "file_system_test" {
   */
 
   # access_control = "0777"
+
+  # Optional. Timeouts of the create, update and delete operations, including any polling. Defaults to 20m each.
+  # timeouts {
+  #   create = "30m"
+  #   update = "30m"
+  #   delete = "30m"
+  # }
 }
 # After the execution of above resource block, a PowerScale FileSystem(Namespace directory) would have been created at PowerScale array. You can also verify the changes made in terraform state file.
@@ -0,0 +1,22 @@
+# Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+# terraform import powerscale_identity_mapping.example [<zone>:]<source>:<target>
+# where the personas are of the form <type>:<value> with a type of SID, UID or GID. IDs whose zone or persona values contain ":" are rejected.
+# Example:
+terraform import powerscale_identity_mapping.example System:SID:S-1-5-21-1111111111-2222222222-3333333333-1001:UID:200001
+# after running this command, populate the source, target and other parameters in the config file to start managing this resource.
+# Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Delete and Import.
+# Updating any argument recreates the identity mapping.
+
+# PowerScale identity mappings pin a source persona, such as a SID, to a target persona, such as a UID or GID.
+resource "powerscale_identity_mapping" "example" {
+  # Required, the personas are of the form SID:<sid>, UID:<uid> or GID:<gid>.
+  source = "SID:S-1-5-21-1111111111-2222222222-3333333333-1001"
+  target = "UID:200001"
+
+  # Optional, the access zone of the mapping. Defaults to the System zone.
+  zone = "System"
+
+  # Optional, whether the reverse mapping from target to source is managed as well. Defaults to false.
+  two_way = true
+
+  # Optional, whether existing mappings of the source are replaced. Defaults to false.
+  replace = true
+}
+
+# After the execution of above resource block, the identity mapping would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Delete.
+# For more information, Please check the terraform state file.
+
+# Creating this resource starts a PowerScale Job Engine job.
+# Changing any of the job parameters starts a new job. Deleting this resource only removes it from the Terraform state, it does not cancel the job.
+resource "powerscale_job" "tree_delete" {
+  # Required. The type of the job.
+  type = "TreeDelete"
+
+  # Optional fields
+  # The paths the job operates on. Required by path based job types such as TreeDelete.
+  paths = ["/ifs/data/old_project"]
+  # The impact policy and priority of the job. Default to the settings of the job type.
+  policy   = "LOW"
+  priority = 5
+  # Whether to start the job even if another job of the same type is already running.
+  allow_dup = false
+  # Whether to wait for the job to complete. Defaults to false.
+  # If true, the resource fails when the job fails.
+  wait_for_completion = true
+}
+
+# After the execution of above resource block, the job would have been started on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale Job Engine impact policies define the impact level at which jobs run during a set of time intervals.
+resource "powerscale_job_impact_policy" "off_hours" {
+  # Required. The name of the impact policy.
+  name = "off_hours"
+  # Required. The time intervals of the impact policy.
+  intervals = [
+    {
+      # Required. The start and end of the interval, in the format '<day> hh:mm'.
+      begin = "Monday 08:00"
+      end   = "Friday 18:00"
+      # Required. Acceptable values are Low, Medium, High and Paused.
+      impact = "Paused"
+    },
+    {
+      begin  = "Friday 18:00"
+      end    = "Monday 08:00"
+      impact = "High"
+    },
+  ]
+
+  # Optional fields
+  description = "Run jobs at high impact outside of business hours"
+}
+
+# After the execution of above resource block, the impact policy would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale Job Engine job types are built into the cluster, so this resource only manages the settings of an existing job type.
+# Deleting this resource only removes it from the Terraform state.
+resource "powerscale_job_type" "integrity_scan" {
+  # Required. The name of the job type.
+  id = "IntegrityScan"
+
+  # Optional fields
+  enabled  = true
+  priority = 1
+  policy   = "LOW"
+  schedule = "every Saturday at 00:00"
+}
+
+# After the execution of above resource block, the job type settings would have been updated on the PowerScale array.
+# For more information, Please check the terraform state file.
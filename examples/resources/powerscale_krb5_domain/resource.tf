@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale Kerberos domain maps a domain to a Kerberos realm.
+resource "powerscale_krb5_domain" "example" {
+  # Required, the name of the domain. Updating this value recreates the resource.
+  domain = ".example.com"
+
+  # Required, the realm the domain is mapped to.
+  realm = powerscale_krb5_realm.example.realm
+}
+
+# After the execution of above resource block, the Kerberos domain would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale Kerberos provider joins the cluster to a Kerberos realm for NFS Kerberos authentication.
+resource "powerscale_krb5_provider" "example" {
+  # Required, the name of the provider and the realm. Updating these values recreates the resource.
+  name  = "EXAMPLE.COM"
+  realm = powerscale_krb5_realm.example.realm
+
+  # Optional, the credentials of a user which can join the cluster to the realm.
+  user     = "admin"
+  password = "password"
+
+  # Optional, the keytab file to import instead of joining with a user.
+  # keytab_file = "/ifs/data/krb5.keytab"
+
+  # Optional, whether the keys are managed manually, with the keytab entries of the SPNs.
+  # manual_keying = true
+  # keytab_entries = [
+  #   {
+  #     kvno     = 2
+  #     enctypes = ["aes256-cts-hmac-sha1-96"]
+  #   }
+  # ]
+}
+
+# After the execution of above resource block, the Kerberos provider would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
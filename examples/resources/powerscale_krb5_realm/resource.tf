@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale Kerberos realm specifies the KDCs and the admin server of a realm used by the standalone Kerberos providers.
+resource "powerscale_krb5_realm" "example" {
+  # Required, the name of the realm. Updating this value recreates the resource.
+  realm = "EXAMPLE.COM"
+
+  # Optional, the KDCs, the admin server and the default domain of the realm.
+  kdc            = ["kdc.example.com"]
+  admin_server   = "kdc.example.com"
+  default_domain = "example.com"
+
+  # Optional, whether the realm is the default realm.
+  is_default_realm = true
+}
+
+# After the execution of above resource block, the Kerberos realm would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# Deleting the resource only removes it from the state.
+
+# PowerScale Kerberos settings configure the Kerberos library defaults of the cluster.
+resource "powerscale_krb5_settings" "example" {
+  # Optional, the realm used when a principal does not specify one.
+  default_realm = powerscale_krb5_realm.example.realm
+
+  # Optional, whether the KDCs and the realms are looked up in DNS.
+  dns_lookup_kdc   = false
+  dns_lookup_realm = false
+
+  # Optional, the KDC request settings.
+  always_send_preauth = true
+  kdc_retries         = 3
+  kdc_timeout         = 5
+
+  # Optional, the preferred encryption types.
+  preferred_enc_types = ["aes256-cts-hmac-sha1-96", "aes128-cts-hmac-sha1-96"]
+}
+
+# After the execution of above resource block, the Kerberos settings would have been updated on the PowerScale array.
+# For more information, Please check the terraform state file.
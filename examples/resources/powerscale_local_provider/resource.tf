@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# Every access zone has a local provider named after the zone. Creating this resource takes over its settings
+# and destroying it only removes it from the state.
+
+# PowerScale local provider authenticates the local users and groups of an access zone.
+resource "powerscale_local_provider" "example" {
+  # Required, the local provider name, which is the name of its access zone. Updating this value recreates the resource.
+  name = "System"
+
+  # Optional, the home directory template and the login shell of the local users.
+  home_directory_template = "/ifs/home/%U"
+  create_home_directory   = true
+  login_shell             = "/bin/zsh"
+  machine_name            = "powerscale"
+
+  # Optional, the account lockout policy.
+  lockout_duration  = 900
+  lockout_threshold = 5
+  lockout_window    = 900
+
+  # Optional, the password policy.
+  max_password_age        = 2592000
+  min_password_age        = 0
+  min_password_length     = 8
+  password_complexity     = ["lowercase", "uppercase", "numeric"]
+  password_history_length = 3
+  password_prompt_time    = 604800
+}
+
+# After the execution of above resource block, the local provider would have been updated on the PowerScale array.
+# For more information, Please check the terraform state file.
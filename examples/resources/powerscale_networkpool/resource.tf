@@ -59,6 +59,13 @@ resource "powerscale_networkpool" "pool_test" {
   #       subnet = "testSubnetAddress"
   #     }
   #   ]
+
+  # Optional. Timeouts of the create, update and delete operations. Defaults to 20m each.
+  # timeouts {
+  #   create = "30m"
+  #   update = "30m"
+  #   delete = "30m"
+  # }
 }
 
 # After the execution of above resource block, network pool would have been created on the PowerScale array.
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale NIS provider authenticates users and groups from NIS servers.
+resource "powerscale_nis_provider" "example" {
+  # Required, the NIS provider name. Updating this value recreates the resource.
+  name = "nis_provider"
+
+  # Required, the NIS domain and the NIS servers.
+  nis_domain = "example.com"
+  servers    = ["10.10.10.10"]
+
+  # Optional, the groupnet of the provider. Updating this value recreates the resource.
+  groupnet = "groupnet0"
+
+  # Optional, the other settings of the provider.
+  enabled               = true
+  balance_servers       = true
+  check_online_interval = 300
+  request_timeout       = 20
+  retry_time            = 5
+  login_shell           = "/bin/zsh"
+}
+
+# After the execution of above resource block, the NIS provider would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale default notification rules apply to all the quotas without notification rules of their own.
+resource "powerscale_quota_default_notification" "advisory_exceeded" {
+  # Required. Acceptable values: hard, soft, advisory.
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  threshold = "advisory"
+  # Required. Acceptable values: exceeded, denied, violated, expired.
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  condition = "exceeded"
+
+  # Optional
+  schedule           = "Every 1 weeks"
+  action_alert       = false
+  action_email_owner = true
+  # action_email_address = ["storage-admins@example.com"]
+  # email_template       = "/ifs/home/admin/quota_email_template.txt"
+}
+
+# After the execution of above resource block, the default notification rule would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
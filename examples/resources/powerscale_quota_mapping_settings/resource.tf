@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale quota mapping settings map the domains of the quota owners to the email domains used for the quota notifications.
+# The configured mappings replace all the existing mappings.
+resource "powerscale_quota_mapping_settings" "example" {
+  # Required. An empty list removes all the mappings.
+  mappings = [
+    {
+      # Required. The FQDN of the source domain to map.
+      domain = "corp.example.com"
+      # Required. The FQDN of the destination domain to map to.
+      mapping = "example.com"
+      # Optional. The type of the source domain.
+      type = "ad"
+    },
+  ]
+}
+
+# After the execution of above resource block, quota mapping settings would have been cached in terraform state file, and
+# quota mapping settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale quota notification rules define the alerts and emails sent when the usage of a quota reaches one of its thresholds.
+# Once a quota has a notification rule of its own, the default notification rules no longer apply to it.
+resource "powerscale_quota_notification" "soft_exceeded" {
+  # Required. The ID of the quota the notification rule belongs to.
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  quota_id = "AABpAQEAAAAAAAAAAAAAQA0AAAAAAAAA"
+  # Required. Acceptable values: hard, soft, advisory.
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  threshold = "soft"
+  # Required. Acceptable values: exceeded, denied, violated, expired.
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  condition = "exceeded"
+
+  # Optional
+  schedule             = "Every 1 days"
+  action_alert         = true
+  action_email_owner   = true
+  action_email_address = ["storage-admins@example.com"]
+  # email_template = "/ifs/home/admin/quota_email_template.txt"
+}
+
+# Notify on every denied write, at most every 10 minutes.
+resource "powerscale_quota_notification" "hard_denied" {
+  quota_id     = "AABpAQEAAAAAAAAAAAAAQA0AAAAAAAAA"
+  threshold    = "hard"
+  condition    = "denied"
+  holdoff      = 600
+  action_alert = true
+}
+
+# After the execution of above resource block, the quota notification rules would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,27 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# Generates an on-demand quota report in the live reports directory of the quota report settings.
+# To generate a new report, replace the resource with 'terraform apply -replace=powerscale_quota_report.example'.
+resource "powerscale_quota_report" "example" {
+}
+
+# After the execution of above resource block, the quota report would have been generated on the PowerScale array.
+# For more information, Please check the terraform state file.
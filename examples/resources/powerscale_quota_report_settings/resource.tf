@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale quota report settings define where and how often the quota reports are generated, and how many are kept.
+resource "powerscale_quota_report_settings" "example" {
+  # Optional fields, the current settings are kept for the fields which are not set.
+  live_dir         = "/ifs/.isilon/smartquotas/reports"
+  live_retain      = 10
+  schedule         = "every day at 1:00"
+  scheduled_dir    = "/ifs/.isilon/smartquotas/reports"
+  scheduled_retain = 30
+}
+
+# After the execution of above resource block, quota report settings would have been cached in terraform state file, and
+# quota report settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
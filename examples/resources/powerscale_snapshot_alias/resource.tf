@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale snapshot aliases are names which point to a snapshot and can be retargeted to a newer snapshot without changing their name.
+resource "powerscale_snapshot" "nightly" {
+  path = "/ifs/data"
+  name = "nightly_snapshot"
+}
+
+resource "powerscale_snapshot_alias" "latest" {
+  # Required. The name of the alias.
+  name = "data_latest"
+  # Required. The name or ID of the snapshot the alias points to.
+  # Updating this field retargets the alias to a different snapshot.
+  target = powerscale_snapshot.nightly.name
+}
+
+# After the execution of above resource block, the snapshot alias would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
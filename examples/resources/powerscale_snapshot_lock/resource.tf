@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale snapshot locks prevent a snapshot from being deleted until all its locks are removed or expired.
+resource "powerscale_snapshot_lock" "in_use" {
+  # Required. The name or ID of the snapshot to lock.
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  snapshot_id = "10"
+
+  # Optional
+  comment = "Snapshot in use by backup tooling"
+  # The Unix Epoch time the lock will expire. If not set, the lock never expires.
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  expires = 1924905600
+}
+
+# After the execution of above resource block, the snapshot lock would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# When lnns is configured a manual node pool is created, otherwise the existing node pool with the given name is managed.
+# Deleting the resource deletes manual node pools, while automatically provisioned node pools are only removed from the state.
+
+# PowerScale node pool groups nodes with the same storage characteristics.
+resource "powerscale_storagepool_nodepool" "example" {
+  # Required, the node pool name.
+  name = "h500_30tb_3.2tb-ssd_128gb"
+
+  # Optional, the nodes of a manual node pool. Updating this value recreates the node pool.
+  # lnns = [1, 2, 3]
+
+  # Optional, the protection policy of the node pool.
+  protection_policy = "+2d:1n"
+
+  # Optional, whether the SSDs of the node pool are used as L3 cache.
+  l3 = true
+
+  # Optional, the percentage of the node pool capacity that SmartPools will fill before transferring data to other pools.
+  # Conflicts with transfer_limit_state.
+  # transfer_limit_pct = 90
+
+  # Optional, how the transfer limit of the node pool is applied. Acceptable values: default, disabled.
+  transfer_limit_state = "default"
+}
+
+# After the execution of above resource block, the node pool would have been updated on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+
+# PowerScale storage pool tier groups node pools.
+resource "powerscale_storagepool_tier" "example" {
+  # Required, the tier name.
+  name = "archive_tier"
+
+  # Optional, the names of the node pools in the tier.
+  children = [powerscale_storagepool_nodepool.example.name]
+
+  # Optional, the percentage of the tier capacity that SmartPools will fill before transferring data to other pools.
+  # Conflicts with transfer_limit_state.
+  transfer_limit_pct = 90
+
+  # Optional, how the transfer limit of the tier is applied. Acceptable values: default, disabled.
+  # transfer_limit_state = "default"
+}
+
+# The tier can be used as the storage pool target of the file pool policies.
+resource "powerscale_filepool_policy" "archive" {
+  name = "archive_policy"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            type     = "name"
+            value    = "*.bak"
+            operator = "=="
+          }
+        ]
+      }
+    ]
+  }
+  actions = [
+    {
+      data_storage_policy_action = {
+        ssd_strategy = "avoid"
+        storagepool  = powerscale_storagepool_tier.example.name
+      }
+      action_type = "apply_data_storage_policy"
+    }
+  ]
+}
+
+# After the execution of above resource block, the storage pool tier would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
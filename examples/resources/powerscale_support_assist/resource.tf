@@ -59,4 +59,11 @@ resource "powerscale_support_assist" "test" {
     ],
     network_pools = ["subnet0:pool0"]
   }
+
+  # Optional. Timeouts of the create, update and delete operations, including any polling. Defaults to 20m each.
+  # timeouts {
+  #   create = "30m"
+  #   update = "30m"
+  #   delete = "30m"
+  # }
 }
\ No newline at end of file
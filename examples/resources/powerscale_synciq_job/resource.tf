@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create and Delete.
+# Creating this resource starts a SyncIQ job. Deleting it only removes it from the Terraform state.
+# For more information, Please check the terraform state file.
+
+# Run a SyncIQ policy and wait for the job to complete.
+resource "powerscale_synciq_job" "run" {
+  # Required. The ID or name of the SyncIQ policy.
+  # Cannot be updated, if the value of this field is changed, Terraform will start a new job.
+  policy_id = "policy_name"
+
+  # Optional
+  # Cannot be updated, if the value of any of these fields is changed, Terraform will start a new job.
+  # Accepted values: run, resync_prep, allow_write, allow_write_revert, test. Defaults to run.
+  action = "run"
+  # source_snapshot = "snapshot_name"
+  # workers_per_node = 3
+
+  # Whether to wait for the job to complete. If true, the apply fails when the job fails. Defaults to true.
+  # The outcome of the job is available in the report attribute.
+  wait_for_completion = true
+
+  # Optional. Timeout of the create operation, including the wait for the job to complete. Defaults to 20m.
+  # timeouts {
+  #   create = "2h"
+  # }
+}
+
+# Fail over to the target cluster. This needs to be run against the target cluster of the policy,
+# where the policy is looked up among the target policies and the report among the target reports.
+resource "powerscale_synciq_job" "failover" {
+  policy_id = "policy_name"
+  action    = "allow_write"
+}
+
+# After the above resource block is applied, we would have the output of the job in the report attribute.
+# Since a job cannot be re-run in place, please use terraform apply -replace to re-run the job.
@@ -124,4 +124,11 @@ resource "powerscale_synciq_policy" "policy_when_source_modified" {
   # which gives a list of all certificates with their short IDs.
   # Then full ID can be looked up using "isi certificate authority view <short ID>".
   ocsp_issuer_certificate_id = "16af57a9f676b0ab126095aa5ebadef22ab31119d644ac95cd4b93dbf3f26aeb"
+
+  # Optional. Timeouts of the create, update and delete operations, including any polling. Defaults to 20m each.
+  # timeouts {
+  #   create = "30m"
+  #   update = "30m"
+  #   delete = "30m"
+  # }
 }
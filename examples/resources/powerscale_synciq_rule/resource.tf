@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# For more information, Please check the terraform state file.
+
+# PowerScale SyncIQ Replication Rules can be used to throttle the bandwidth, file count, CPU or worker usage of SyncIQ jobs.
+resource "powerscale_synciq_rule" "bandwidth_rule" {
+  # Required
+  # Cannot be updated, if the value of this field is changed, Terraform will destroy this resource and recreate it.
+  # Accepted values: bandwidth, file_count, cpu, worker
+  type = "bandwidth"
+  # Required. Units are kb/s for bandwidth, files/s for file_count, processing percentage for cpu, or percentage of maximum available workers for worker.
+  limit = 10000
+
+  # Optional
+  description = "Limit SyncIQ bandwidth during business hours"
+  enabled     = true
+  schedule = {
+    begin     = "08:00"
+    end       = "18:00"
+    monday    = true
+    tuesday   = true
+    wednesday = true
+    thursday  = true
+    friday    = true
+    saturday  = false
+    sunday    = false
+  }
+}
+
+# After the execution of above resource block, SyncIQ Replication Rule would have been cached in terraform state file, or
+# SyncIQ Replication Rule would have been created/updated on PowerScale.
+# For more information, Please check the terraform state file.
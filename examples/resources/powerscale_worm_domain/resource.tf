@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update and Import.
+# A WORM domain cannot be deleted from the PowerScale array, destroying the resource only removes it from the state.
+# The path and the type of a domain cannot be changed, and a privileged delete which is disabled cannot be enabled again.
+
+# PowerScale WORM domain allows you to commit the files of a directory to a write once, read many state.
+resource "powerscale_worm_domain" "example" {
+  # Required, the root directory of the domain
+  path = "/ifs/regulated/records"
+
+  # Optional fields
+  # enterprise (default) or compliance, a compliance domain requires a cluster in compliance mode
+  type = "enterprise"
+  # Commits the files which are not modified for an hour
+  autocommit_offset = 3600
+  # Retentions are forever or a period using the units Y, M, W, D, H, m and s. default_retention can also be use_min or use_max.
+  default_retention = "7Y"
+  min_retention     = "1Y"
+  max_retention     = "10Y"
+  # override_date   = 1893456000
+  # on, off or disabled, disabling privileged delete is permanent
+  privileged_delete = "off"
+}
+
+# After the execution of above resource block, the WORM domain would have been created on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -0,0 +1,29 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# Deleting the resource only removes it from the state.
+
+# PowerScale WORM settings provide the compliance clock of the cluster.
+resource "powerscale_worm_settings" "example" {
+  # Optional, sets the compliance clock to the current time of the cluster.
+  # The compliance clock can only be set once and cannot be unset.
+  set_compliance_clock = true
+}
+
+# After the execution of above resource block, the WORM settings would have been updated on the PowerScale array.
+# For more information, Please check the terraform state file.
@@ -420,6 +420,27 @@ const (
 	// ListSynciqRulesMsg specifies error details occurred while listing snapshot schedules.
 	ListSynciqRulesMsg = "Could not list SyncIQ rules "
 
+	// CreateSynciqRuleErrorMsg specifies error details occurred while creating a SyncIQ rule.
+	CreateSynciqRuleErrorMsg = "Could not create SyncIQ rule "
+
+	// ReadSynciqRuleErrorMsg specifies error details occurred while reading a SyncIQ rule.
+	ReadSynciqRuleErrorMsg = "Could not read SyncIQ rule "
+
+	// UpdateSynciqRuleErrorMsg specifies error details occurred while updating a SyncIQ rule.
+	UpdateSynciqRuleErrorMsg = "Could not update SyncIQ rule "
+
+	// DeleteSynciqRuleErrorMsg specifies error details occurred while deleting a SyncIQ rule.
+	DeleteSynciqRuleErrorMsg = "Could not delete SyncIQ rule "
+
+	// ListSynciqTargetPoliciesMsg specifies error details occurred while listing SyncIQ target policies.
+	ListSynciqTargetPoliciesMsg = "Could not list SyncIQ target policies "
+
+	// ListSynciqReportsMsg specifies error details occurred while listing SyncIQ reports.
+	ListSynciqReportsMsg = "Could not list SyncIQ reports "
+
+	// ListSynciqTargetReportsMsg specifies error details occurred while listing SyncIQ target reports.
+	ListSynciqTargetReportsMsg = "Could not list SyncIQ target reports "
+
 	// UpdateSupportAssistStatusErrorMsg specifies error details occurred while updating support assist status.
 	UpdateSupportAssistStatusErrorMsg = "Could not update support assist status "
 
@@ -443,4 +464,316 @@ const (
 
 	// GetClusterVersionErrorMsg specifies error details occurred while getting cluster version.
 	GetClusterVersionErrorMsg = "Could not get cluster version"
+
+	// CreateSnapshotAliasErrorMsg specifies error details occurred while creating a snapshot alias.
+	CreateSnapshotAliasErrorMsg = "Could not create snapshot alias "
+
+	// ReadSnapshotAliasErrorMsg specifies error details occurred while reading a snapshot alias.
+	ReadSnapshotAliasErrorMsg = "Could not read snapshot alias "
+
+	// UpdateSnapshotAliasErrorMsg specifies error details occurred while updating a snapshot alias.
+	UpdateSnapshotAliasErrorMsg = "Could not update snapshot alias "
+
+	// DeleteSnapshotAliasErrorMsg specifies error details occurred while deleting a snapshot alias.
+	DeleteSnapshotAliasErrorMsg = "Could not delete snapshot alias "
+
+	// CreateSnapshotLockErrorMsg specifies error details occurred while creating a snapshot lock.
+	CreateSnapshotLockErrorMsg = "Could not create snapshot lock "
+
+	// ReadSnapshotLockErrorMsg specifies error details occurred while reading a snapshot lock.
+	ReadSnapshotLockErrorMsg = "Could not read snapshot lock "
+
+	// UpdateSnapshotLockErrorMsg specifies error details occurred while updating a snapshot lock.
+	UpdateSnapshotLockErrorMsg = "Could not update snapshot lock "
+
+	// DeleteSnapshotLockErrorMsg specifies error details occurred while deleting a snapshot lock.
+	DeleteSnapshotLockErrorMsg = "Could not delete snapshot lock "
+
+	// ReadJobTypeErrorMsg specifies error details occurred while reading a job type.
+	ReadJobTypeErrorMsg = "Could not read job type "
+
+	// UpdateJobTypeErrorMsg specifies error details occurred while updating a job type.
+	UpdateJobTypeErrorMsg = "Could not update job type "
+
+	// CreateJobImpactPolicyErrorMsg specifies error details occurred while creating a job impact policy.
+	CreateJobImpactPolicyErrorMsg = "Could not create job impact policy "
+
+	// ReadJobImpactPolicyErrorMsg specifies error details occurred while reading a job impact policy.
+	ReadJobImpactPolicyErrorMsg = "Could not read job impact policy "
+
+	// UpdateJobImpactPolicyErrorMsg specifies error details occurred while updating a job impact policy.
+	UpdateJobImpactPolicyErrorMsg = "Could not update job impact policy "
+
+	// DeleteJobImpactPolicyErrorMsg specifies error details occurred while deleting a job impact policy.
+	DeleteJobImpactPolicyErrorMsg = "Could not delete job impact policy "
+
+	// ListJobsErrorMsg specifies error details occurred while listing jobs.
+	ListJobsErrorMsg = "Could not list jobs "
+
+	// CreateQuotaNotificationErrorMsg specifies error details occurred while creating quota notification.
+	CreateQuotaNotificationErrorMsg = "Could not create quota notification "
+
+	// ReadQuotaNotificationErrorMsg specifies error details occurred while reading quota notification.
+	ReadQuotaNotificationErrorMsg = "Could not read quota notification "
+
+	// UpdateQuotaNotificationErrorMsg specifies error details occurred while updating quota notification.
+	UpdateQuotaNotificationErrorMsg = "Could not update quota notification "
+
+	// DeleteQuotaNotificationErrorMsg specifies error details occurred while deleting quota notification.
+	DeleteQuotaNotificationErrorMsg = "Could not delete quota notification "
+
+	// CreateQuotaDefaultNotificationErrorMsg specifies error details occurred while creating quota default notification.
+	CreateQuotaDefaultNotificationErrorMsg = "Could not create quota default notification "
+
+	// ReadQuotaDefaultNotificationErrorMsg specifies error details occurred while reading quota default notification.
+	ReadQuotaDefaultNotificationErrorMsg = "Could not read quota default notification "
+
+	// UpdateQuotaDefaultNotificationErrorMsg specifies error details occurred while updating quota default notification.
+	UpdateQuotaDefaultNotificationErrorMsg = "Could not update quota default notification "
+
+	// DeleteQuotaDefaultNotificationErrorMsg specifies error details occurred while deleting quota default notification.
+	DeleteQuotaDefaultNotificationErrorMsg = "Could not delete quota default notification "
+
+	// ReadQuotaReportSettingsErrorMsg specifies error details occurred while reading quota report settings.
+	ReadQuotaReportSettingsErrorMsg = "Could not read quota report settings "
+
+	// UpdateQuotaReportSettingsErrorMsg specifies error details occurred while updating quota report settings.
+	UpdateQuotaReportSettingsErrorMsg = "Could not update quota report settings "
+
+	// ReadQuotaMappingSettingsErrorMsg specifies error details occurred while reading quota mapping settings.
+	ReadQuotaMappingSettingsErrorMsg = "Could not read quota mapping settings "
+
+	// UpdateQuotaMappingSettingsErrorMsg specifies error details occurred while updating quota mapping settings.
+	UpdateQuotaMappingSettingsErrorMsg = "Could not update quota mapping settings "
+
+	// CreateQuotaReportErrorMsg specifies error details occurred while generating quota report.
+	CreateQuotaReportErrorMsg = "Could not generate quota report "
+
+	// ReadQuotaReportErrorMsg specifies error details occurred while reading quota report.
+	ReadQuotaReportErrorMsg = "Could not read quota report "
+
+	// DeleteQuotaReportErrorMsg specifies error details occurred while deleting quota report.
+	DeleteQuotaReportErrorMsg = "Could not delete quota report "
+
+	// ListQuotaReportsErrorMsg specifies error details occurred while listing quota reports.
+	ListQuotaReportsErrorMsg = "Could not list quota reports "
+
+	// CreateFileErrorMsg specifies error details occurred while creating file.
+	CreateFileErrorMsg = "Could not create file "
+
+	// ReadFileErrorMsg specifies error details occurred while reading file.
+	ReadFileErrorMsg = "Could not read file "
+
+	// UpdateFileErrorMsg specifies error details occurred while updating file.
+	UpdateFileErrorMsg = "Could not update file "
+
+	// DeleteFileErrorMsg specifies error details occurred while deleting file.
+	DeleteFileErrorMsg = "Could not delete file "
+
+	// CopyFileSystemErrorMsg specifies error details occurred while copying file system.
+	CopyFileSystemErrorMsg = "Could not copy file system "
+
+	// ReadFileSystemCopyErrorMsg specifies error details occurred while reading file system copy.
+	ReadFileSystemCopyErrorMsg = "Could not read file system copy "
+
+	// SetFileSystemAttributesErrorMsg specifies error details occurred while setting file system attributes.
+	SetFileSystemAttributesErrorMsg = "Could not set file system attributes "
+
+	// CreateWormDomainErrorMsg specifies error details occurred while creating WORM domain.
+	CreateWormDomainErrorMsg = "Could not create WORM domain "
+
+	// ReadWormDomainErrorMsg specifies error details occurred while reading WORM domain.
+	ReadWormDomainErrorMsg = "Could not read WORM domain "
+
+	// UpdateWormDomainErrorMsg specifies error details occurred while updating WORM domain.
+	UpdateWormDomainErrorMsg = "Could not update WORM domain "
+
+	// ReadWormSettingsErrorMsg specifies error details occurred while reading WORM settings.
+	ReadWormSettingsErrorMsg = "Could not read WORM settings "
+
+	// UpdateWormSettingsErrorMsg specifies error details occurred while updating WORM settings.
+	UpdateWormSettingsErrorMsg = "Could not update WORM settings "
+
+	// ReadDedupeSettingsErrorMsg specifies error details occurred while reading dedupe settings.
+	ReadDedupeSettingsErrorMsg = "Could not read dedupe settings "
+
+	// UpdateDedupeSettingsErrorMsg specifies error details occurred while updating dedupe settings.
+	UpdateDedupeSettingsErrorMsg = "Could not update dedupe settings "
+
+	// ReadDedupeSummaryErrorMsg specifies error details occurred while reading dedupe summary.
+	ReadDedupeSummaryErrorMsg = "Could not read dedupe summary "
+
+	// ListDedupeReportsErrorMsg specifies error details occurred while listing dedupe reports.
+	ListDedupeReportsErrorMsg = "Could not list dedupe reports "
+
+	// CreateStoragepoolTierErrorMsg specifies error details occurred while creating storage pool tier.
+	CreateStoragepoolTierErrorMsg = "Could not create storage pool tier "
+
+	// ReadStoragepoolTierErrorMsg specifies error details occurred while reading storage pool tier.
+	ReadStoragepoolTierErrorMsg = "Could not read storage pool tier "
+
+	// UpdateStoragepoolTierErrorMsg specifies error details occurred while updating storage pool tier.
+	UpdateStoragepoolTierErrorMsg = "Could not update storage pool tier "
+
+	// DeleteStoragepoolTierErrorMsg specifies error details occurred while deleting storage pool tier.
+	DeleteStoragepoolTierErrorMsg = "Could not delete storage pool tier "
+
+	// CreateStoragepoolNodepoolErrorMsg specifies error details occurred while creating node pool.
+	CreateStoragepoolNodepoolErrorMsg = "Could not create node pool "
+
+	// ReadStoragepoolNodepoolErrorMsg specifies error details occurred while reading node pool.
+	ReadStoragepoolNodepoolErrorMsg = "Could not read node pool "
+
+	// UpdateStoragepoolNodepoolErrorMsg specifies error details occurred while updating node pool.
+	UpdateStoragepoolNodepoolErrorMsg = "Could not update node pool "
+
+	// DeleteStoragepoolNodepoolErrorMsg specifies error details occurred while deleting node pool.
+	DeleteStoragepoolNodepoolErrorMsg = "Could not delete node pool "
+
+	// ListStoragepoolsErrorMsg specifies error details occurred while listing storage pools.
+	ListStoragepoolsErrorMsg = "Could not list storage pools "
+
+	// CreateCloudPoolsAccountErrorMsg specifies error details occurred while creating CloudPools account.
+	CreateCloudPoolsAccountErrorMsg = "Could not create CloudPools account "
+
+	// ReadCloudPoolsAccountErrorMsg specifies error details occurred while reading CloudPools account.
+	ReadCloudPoolsAccountErrorMsg = "Could not read CloudPools account "
+
+	// UpdateCloudPoolsAccountErrorMsg specifies error details occurred while updating CloudPools account.
+	UpdateCloudPoolsAccountErrorMsg = "Could not update CloudPools account "
+
+	// DeleteCloudPoolsAccountErrorMsg specifies error details occurred while deleting CloudPools account.
+	DeleteCloudPoolsAccountErrorMsg = "Could not delete CloudPools account "
+
+	// CreateCloudPoolsPoolErrorMsg specifies error details occurred while creating CloudPools pool.
+	CreateCloudPoolsPoolErrorMsg = "Could not create CloudPools pool "
+
+	// ReadCloudPoolsPoolErrorMsg specifies error details occurred while reading CloudPools pool.
+	ReadCloudPoolsPoolErrorMsg = "Could not read CloudPools pool "
+
+	// UpdateCloudPoolsPoolErrorMsg specifies error details occurred while updating CloudPools pool.
+	UpdateCloudPoolsPoolErrorMsg = "Could not update CloudPools pool "
+
+	// DeleteCloudPoolsPoolErrorMsg specifies error details occurred while deleting CloudPools pool.
+	DeleteCloudPoolsPoolErrorMsg = "Could not delete CloudPools pool "
+
+	// ReadCloudPoolsSettingsErrorMsg specifies error details occurred while reading CloudPools settings.
+	ReadCloudPoolsSettingsErrorMsg = "Could not read CloudPools settings "
+
+	// UpdateCloudPoolsSettingsErrorMsg specifies error details occurred while updating CloudPools settings.
+	UpdateCloudPoolsSettingsErrorMsg = "Could not update CloudPools settings "
+
+	// ReadLocalProviderErrorMsg specifies error details occurred while reading local provider.
+	ReadLocalProviderErrorMsg = "Could not read local provider "
+
+	// UpdateLocalProviderErrorMsg specifies error details occurred while updating local provider.
+	UpdateLocalProviderErrorMsg = "Could not update local provider "
+
+	// ListLocalProvidersErrorMsg specifies error details occurred while listing local providers.
+	ListLocalProvidersErrorMsg = "Could not list local providers "
+
+	// CreateFileProviderErrorMsg specifies error details occurred while creating file provider.
+	CreateFileProviderErrorMsg = "Could not create file provider "
+
+	// ReadFileProviderErrorMsg specifies error details occurred while reading file provider.
+	ReadFileProviderErrorMsg = "Could not read file provider "
+
+	// UpdateFileProviderErrorMsg specifies error details occurred while updating file provider.
+	UpdateFileProviderErrorMsg = "Could not update file provider "
+
+	// DeleteFileProviderErrorMsg specifies error details occurred while deleting file provider.
+	DeleteFileProviderErrorMsg = "Could not delete file provider "
+
+	// ListFileProvidersErrorMsg specifies error details occurred while listing file providers.
+	ListFileProvidersErrorMsg = "Could not list file providers "
+
+	// CreateNISProviderErrorMsg specifies error details occurred while creating NIS provider.
+	CreateNISProviderErrorMsg = "Could not create NIS provider "
+
+	// ReadNISProviderErrorMsg specifies error details occurred while reading NIS provider.
+	ReadNISProviderErrorMsg = "Could not read NIS provider "
+
+	// UpdateNISProviderErrorMsg specifies error details occurred while updating NIS provider.
+	UpdateNISProviderErrorMsg = "Could not update NIS provider "
+
+	// DeleteNISProviderErrorMsg specifies error details occurred while deleting NIS provider.
+	DeleteNISProviderErrorMsg = "Could not delete NIS provider "
+
+	// ListNISProvidersErrorMsg specifies error details occurred while listing NIS providers.
+	ListNISProvidersErrorMsg = "Could not list NIS providers "
+
+	// CreateKrb5RealmErrorMsg specifies error details occurred while creating Kerberos realm.
+	CreateKrb5RealmErrorMsg = "Could not create Kerberos realm "
+
+	// ReadKrb5RealmErrorMsg specifies error details occurred while reading Kerberos realm.
+	ReadKrb5RealmErrorMsg = "Could not read Kerberos realm "
+
+	// UpdateKrb5RealmErrorMsg specifies error details occurred while updating Kerberos realm.
+	UpdateKrb5RealmErrorMsg = "Could not update Kerberos realm "
+
+	// DeleteKrb5RealmErrorMsg specifies error details occurred while deleting Kerberos realm.
+	DeleteKrb5RealmErrorMsg = "Could not delete Kerberos realm "
+
+	// CreateKrb5DomainErrorMsg specifies error details occurred while creating Kerberos domain.
+	CreateKrb5DomainErrorMsg = "Could not create Kerberos domain "
+
+	// ReadKrb5DomainErrorMsg specifies error details occurred while reading Kerberos domain.
+	ReadKrb5DomainErrorMsg = "Could not read Kerberos domain "
+
+	// UpdateKrb5DomainErrorMsg specifies error details occurred while updating Kerberos domain.
+	UpdateKrb5DomainErrorMsg = "Could not update Kerberos domain "
+
+	// DeleteKrb5DomainErrorMsg specifies error details occurred while deleting Kerberos domain.
+	DeleteKrb5DomainErrorMsg = "Could not delete Kerberos domain "
+
+	// CreateKrb5ProviderErrorMsg specifies error details occurred while creating Kerberos provider.
+	CreateKrb5ProviderErrorMsg = "Could not create Kerberos provider "
+
+	// ReadKrb5ProviderErrorMsg specifies error details occurred while reading Kerberos provider.
+	ReadKrb5ProviderErrorMsg = "Could not read Kerberos provider "
+
+	// UpdateKrb5ProviderErrorMsg specifies error details occurred while updating Kerberos provider.
+	UpdateKrb5ProviderErrorMsg = "Could not update Kerberos provider "
+
+	// DeleteKrb5ProviderErrorMsg specifies error details occurred while deleting Kerberos provider.
+	DeleteKrb5ProviderErrorMsg = "Could not delete Kerberos provider "
+
+	// ReadKrb5SettingsErrorMsg specifies error details occurred while reading Kerberos settings.
+	ReadKrb5SettingsErrorMsg = "Could not read Kerberos settings "
+
+	// UpdateKrb5SettingsErrorMsg specifies error details occurred while updating Kerberos settings.
+	UpdateKrb5SettingsErrorMsg = "Could not update Kerberos settings "
+
+	// ReadAdsProviderDomainControllersErrorMsg specifies error details occurred while reading ads provider domain controllers.
+	ReadAdsProviderDomainControllersErrorMsg = "Could not read ads provider domain controllers "
+
+	// ReadAdsProviderTrustedDomainsErrorMsg specifies error details occurred while reading ads provider trusted domains.
+	ReadAdsProviderTrustedDomainsErrorMsg = "Could not read ads provider trusted domains "
+
+	// ReadAuthGlobalSettingsErrorMsg specifies error details occurred while reading Auth Global Settings.
+	ReadAuthGlobalSettingsErrorMsg = "Could not read auth global settings "
+
+	// CreateAuthGlobalSettingsErrorMsg specifies error details occurred while creating Auth Global Settings.
+	CreateAuthGlobalSettingsErrorMsg = "Could not create auth global settings "
+
+	// UpdateAuthGlobalSettingsErrorMsg specifies error details occurred while updating Auth Global Settings.
+	UpdateAuthGlobalSettingsErrorMsg = "Could not update auth global settings "
+
+	// ReadAuthMappingSettingsErrorMsg specifies error details occurred while reading Auth Mapping Settings.
+	ReadAuthMappingSettingsErrorMsg = "Could not read auth mapping settings "
+
+	// CreateAuthMappingSettingsErrorMsg specifies error details occurred while creating Auth Mapping Settings.
+	CreateAuthMappingSettingsErrorMsg = "Could not create auth mapping settings "
+
+	// UpdateAuthMappingSettingsErrorMsg specifies error details occurred while updating Auth Mapping Settings.
+	UpdateAuthMappingSettingsErrorMsg = "Could not update auth mapping settings "
+
+	// CreateIdentityMappingErrorMsg specifies error details occurred while creating identity mapping.
+	CreateIdentityMappingErrorMsg = "Could not create identity mapping "
+
+	// ReadIdentityMappingErrorMsg specifies error details occurred while reading identity mapping.
+	ReadIdentityMappingErrorMsg = "Could not read identity mapping "
+
+	// DeleteIdentityMappingErrorMsg specifies error details occurred while deleting identity mapping.
+	DeleteIdentityMappingErrorMsg = "Could not delete identity mapping "
 )
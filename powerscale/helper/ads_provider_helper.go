@@ -20,8 +20,21 @@ package helper
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// AdsProviderSpnsCheckMode only reports the missing SPNs of an Ads Provider.
+	AdsProviderSpnsCheckMode = "check"
+	// AdsProviderSpnsFixMode adds the missing SPNs to an Ads Provider.
+	AdsProviderSpnsFixMode = "fix"
 )
 
 // AdsProviderDetailMapper Does the mapping from response to model.
@@ -95,3 +108,109 @@ func IsGroupnetUpdated(groupnetInPlan string, groupnetInResp string) bool {
 	}
 	return groupnetInResp != groupnetInPlan
 }
+
+// GetMissingAdsProviderSpns returns the recommended and the extra expected SPNs which are not configured on the Ads Provider.
+func GetMissingAdsProviderSpns(ads *powerscale.V14ProvidersAdsAdsItem) []string {
+	configured := make(map[string]bool)
+	for _, spn := range ads.GetSpns() {
+		configured[strings.ToLower(spn)] = true
+	}
+	expected := append([]string{}, ads.GetRecommendedSpns()...)
+	expected = append(expected, ads.GetExtraExpectedSpns()...)
+	missing := make([]string, 0)
+	for _, spn := range expected {
+		if !configured[strings.ToLower(spn)] {
+			configured[strings.ToLower(spn)] = true
+			missing = append(missing, spn)
+		}
+	}
+	return missing
+}
+
+// ReconcileAdsProviderSpns adds the missing SPNs to the Ads Provider in fix mode, and returns the up-to-date Ads Provider.
+func ReconcileAdsProviderSpns(ctx context.Context, client *client.Client, adsModel models.AdsProviderResourceModel, ads *powerscale.V14ProvidersAdsAdsItem) (*powerscale.V14ProvidersAdsAdsItem, error) {
+	missing := GetMissingAdsProviderSpns(ads)
+	if adsModel.SpnsReconcileMode.ValueString() != AdsProviderSpnsFixMode || len(missing) == 0 {
+		return ads, nil
+	}
+	adsToUpdate := powerscale.V14ProvidersAdsIdParams{
+		Spns: append(append([]string{}, ads.GetSpns()...), missing...),
+	}
+	if err := UpdateAdsProvider(ctx, client, adsModel.ID.ValueString(), adsToUpdate); err != nil {
+		return nil, err
+	}
+	adsRes, err := GetAdsProvider(ctx, client, adsModel)
+	if err != nil {
+		return nil, err
+	}
+	if len(adsRes.Ads) <= 0 {
+		return nil, fmt.Errorf("could not read ads provider %s after fixing the SPNs", adsModel.ID.ValueString())
+	}
+	return &adsRes.Ads[0], nil
+}
+
+// SetAdsProviderMissingSpns sets the missing SPNs of the Ads Provider model, and warns about them in check mode.
+func SetAdsProviderMissingSpns(ctx context.Context, adsModel *models.AdsProviderResourceModel, ads *powerscale.V14ProvidersAdsAdsItem) diag.Diagnostics {
+	missing := GetMissingAdsProviderSpns(ads)
+	missingSpns, diags := types.ListValueFrom(ctx, types.StringType, missing)
+	adsModel.MissingSpns = missingSpns
+	if adsModel.SpnsReconcileMode.ValueString() == AdsProviderSpnsCheckMode && len(missing) > 0 {
+		diags.AddWarning(
+			"Missing SPNs of ads provider",
+			fmt.Sprintf("The ads provider %s is missing the SPNs: %s", adsModel.ID.ValueString(), strings.Join(missing, ", ")),
+		)
+	}
+	return diags
+}
+
+// GetAdsProviderDomainControllers retrieve the domain controllers of an Ads Provider.
+func GetAdsProviderDomainControllers(ctx context.Context, client *client.Client, adsID string) ([]powerscale.V3ProvidersAdsIdControllersController, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv3ProvidersAdsIdControllers(ctx, adsID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return result.Controllers, nil
+}
+
+// NewAdsProviderDomainControllerDataSource creates a new AdsProviderDomainControllerDataSourceModel from the domain controllers.
+func NewAdsProviderDomainControllerDataSource(ctx context.Context, adsID string, controllers []powerscale.V3ProvidersAdsIdControllersController) (*models.AdsProviderDomainControllerDataSourceModel, error) {
+	var err error
+	dsControllers := make([]models.AdsProviderDomainControllerModel, len(controllers))
+	for i := range controllers {
+		err = errors.Join(err, CopyFields(ctx, &controllers[i], &dsControllers[i]))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.AdsProviderDomainControllerDataSourceModel{
+		ID:                types.StringValue("adsprovider_domain_controller_datasource"),
+		AdsProviderID:     types.StringValue(adsID),
+		DomainControllers: dsControllers,
+	}, nil
+}
+
+// GetAdsProviderTrustedDomains retrieve the trusted domains of an Ads Provider.
+func GetAdsProviderTrustedDomains(ctx context.Context, client *client.Client, adsID string) ([]powerscale.V1ProvidersAdsIdDomainsDomain, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1ProvidersAdsIdDomains(ctx, adsID).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return result.Domains, nil
+}
+
+// NewAdsProviderTrustedDomainDataSource creates a new AdsProviderTrustedDomainDataSourceModel from the trusted domains.
+func NewAdsProviderTrustedDomainDataSource(ctx context.Context, adsID string, domains []powerscale.V1ProvidersAdsIdDomainsDomain) (*models.AdsProviderTrustedDomainDataSourceModel, error) {
+	var err error
+	dsDomains := make([]models.AdsProviderTrustedDomainModel, len(domains))
+	for i := range domains {
+		err = errors.Join(err, CopyFields(ctx, &domains[i], &dsDomains[i]))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.AdsProviderTrustedDomainDataSourceModel{
+		ID:             types.StringValue("adsprovider_trusted_domain_datasource"),
+		AdsProviderID:  types.StringValue(adsID),
+		TrustedDomains: dsDomains,
+	}, nil
+}
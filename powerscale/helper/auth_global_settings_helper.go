@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+)
+
+// AuthGlobalSettingsDetailMapper Does the mapping from response to model.
+//
+//go:noinline
+func AuthGlobalSettingsDetailMapper(ctx context.Context, authGlobalSettings *powerscale.V7SettingsGlobalGlobalSettings) (models.AuthGlobalSettingsDataSourceModel, error) {
+	model := models.AuthGlobalSettingsDataSourceModel{}
+	err := CopyFields(ctx, authGlobalSettings, &model)
+	return model, err
+}
+
+// GetAuthGlobalSettings retrieve Auth Global Settings information.
+func GetAuthGlobalSettings(ctx context.Context, client *client.Client, zone string) (*powerscale.V7SettingsGlobal, error) {
+	queryParam := client.PscaleOpenAPIClient.AuthApi.GetAuthv7SettingsGlobal(ctx)
+	if zone != "" {
+		queryParam = queryParam.Zone(zone)
+	}
+	authGlobalSettingsRes, _, err := queryParam.Execute()
+	return authGlobalSettingsRes, err
+}
+
+// UpdateAuthGlobalSettings Update Auth Global Settings.
+func UpdateAuthGlobalSettings(ctx context.Context, client *client.Client, zone string, authGlobalSettingsToUpdate powerscale.V7SettingsGlobalGlobalSettings) error {
+	updateParam := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv7SettingsGlobal(ctx)
+	if zone != "" {
+		updateParam = updateParam.Zone(zone)
+	}
+	_, err := updateParam.V7SettingsGlobal(authGlobalSettingsToUpdate).Execute()
+	return err
+}
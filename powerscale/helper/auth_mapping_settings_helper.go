@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+)
+
+// AuthMappingSettingsDetailMapper Does the mapping from response to model.
+//
+//go:noinline
+func AuthMappingSettingsDetailMapper(ctx context.Context, authMappingSettings *powerscale.V1SettingsMappingMapping) (models.AuthMappingSettingsDataSourceModel, error) {
+	model := models.AuthMappingSettingsDataSourceModel{}
+	err := CopyFields(ctx, authMappingSettings, &model)
+	return model, err
+}
+
+// GetAuthMappingSettings retrieve Auth Mapping Settings information.
+func GetAuthMappingSettings(ctx context.Context, client *client.Client, zone string) (*powerscale.V1SettingsMapping, error) {
+	queryParam := client.PscaleOpenAPIClient.AuthApi.GetAuthv1SettingsMapping(ctx)
+	if zone != "" {
+		queryParam = queryParam.Zone(zone)
+	}
+	authMappingSettingsRes, _, err := queryParam.Execute()
+	return authMappingSettingsRes, err
+}
+
+// UpdateAuthMappingSettings Update Auth Mapping Settings.
+func UpdateAuthMappingSettings(ctx context.Context, client *client.Client, zone string, authMappingSettingsToUpdate powerscale.V1SettingsMappingMapping) error {
+	updateParam := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1SettingsMapping(ctx)
+	if zone != "" {
+		updateParam = updateParam.Zone(zone)
+	}
+	_, err := updateParam.V1SettingsMapping(authMappingSettingsToUpdate).Execute()
+	return err
+}
@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// cloudPolicyCacheJSON is the default cache settings of the cloud policies.
+type cloudPolicyCacheJSON struct {
+	Expiration *int64  `json:"expiration,omitempty"`
+	ReadAhead  *string `json:"read_ahead,omitempty"`
+	Type       *string `json:"type,omitempty"`
+}
+
+// cloudPolicyDefaultsJSON is the default values of the cloud policies.
+type cloudPolicyDefaultsJSON struct {
+	ArchiveSnapshotFiles       *bool                 `json:"archive_snapshot_files,omitempty"`
+	Cache                      *cloudPolicyCacheJSON `json:"cache,omitempty"`
+	Compression                *bool                 `json:"compression,omitempty"`
+	DataRetention              *int64                `json:"data_retention,omitempty"`
+	Encryption                 *bool                 `json:"encryption,omitempty"`
+	FullBackupRetention        *int64                `json:"full_backup_retention,omitempty"`
+	IncrementalBackupRetention *int64                `json:"incremental_backup_retention,omitempty"`
+	WritebackFrequency         *int64                `json:"writeback_frequency,omitempty"`
+}
+
+// cloudSettingsJSON is the CloudPools settings managed by the provider.
+type cloudSettingsJSON struct {
+	CloudPolicyDefaults *cloudPolicyDefaultsJSON `json:"cloud_policy_defaults,omitempty"`
+}
+
+// CreateCloudPoolsAccount creates a CloudPools account.
+func CreateCloudPoolsAccount(ctx context.Context, client *client.Client, plan *models.CloudPoolsAccountResourceModel) (string, error) {
+	createBody := powerscale.V4CloudAccount{}
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return "", err
+	}
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.CreateCloudv4CloudAccount(ctx).V4CloudAccount(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.GetId(), nil
+}
+
+// UpdateCloudPoolsAccount updates a CloudPools account.
+func UpdateCloudPoolsAccount(ctx context.Context, client *client.Client, id string, plan *models.CloudPoolsAccountResourceModel) error {
+	editBody := powerscale.V4CloudAccountExtendedExtended{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.CloudApi.UpdateCloudv4CloudAccount(ctx, id).V4CloudAccount(editBody).Execute()
+	return err
+}
+
+// DeleteCloudPoolsAccount deletes a CloudPools account.
+func DeleteCloudPoolsAccount(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.CloudApi.DeleteCloudv4CloudAccount(ctx, id).Execute()
+	return err
+}
+
+// GetCloudPoolsAccountState reads a CloudPools account and maps it to the resource model.
+// The secret key and skip_account_check are not returned by the array, so they are kept from the state.
+func GetCloudPoolsAccountState(ctx context.Context, client *client.Client, id string, state *models.CloudPoolsAccountResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.GetCloudv4CloudAccount(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Accounts) == 0 {
+		return fmt.Errorf("could not find CloudPools account with ID %s", id)
+	}
+	account := result.Accounts[0]
+	state.ID = types.StringValue(account.GetId())
+	state.Name = types.StringValue(account.GetName())
+	state.Type = types.StringValue(account.GetType())
+	state.URI = types.StringValue(account.GetUri())
+	state.AccountUsername = types.StringValue(account.GetAccountUsername())
+	state.AccountID = types.StringValue(account.GetAccountId())
+	state.Enabled = types.BoolValue(account.GetEnabled())
+	state.SkipSSLValidation = types.BoolValue(account.GetSkipSslValidation())
+	state.StorageRegion = types.StringValue(account.GetStorageRegion())
+	state.TelemetryBucket = types.StringValue(account.GetTelemetryBucket())
+	state.Proxy = types.StringValue(account.GetProxy())
+	state.State = types.StringValue(account.GetState())
+	state.BirthClusterID = types.StringValue(account.GetBirthClusterId())
+	if state.SkipAccountCheck.IsUnknown() {
+		state.SkipAccountCheck = types.BoolNull()
+	}
+	return nil
+}
+
+// CreateCloudPoolsPool creates a CloudPools pool.
+func CreateCloudPoolsPool(ctx context.Context, client *client.Client, plan *models.CloudPoolsPoolResourceModel) (string, error) {
+	createBody := powerscale.V4CloudPool{}
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return "", err
+	}
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.CreateCloudv4CloudPool(ctx).V4CloudPool(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.GetId(), nil
+}
+
+// UpdateCloudPoolsPool updates a CloudPools pool.
+func UpdateCloudPoolsPool(ctx context.Context, client *client.Client, id string, plan *models.CloudPoolsPoolResourceModel) error {
+	editBody := powerscale.V4CloudPoolExtendedExtended{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.CloudApi.UpdateCloudv4CloudPool(ctx, id).V4CloudPool(editBody).Execute()
+	return err
+}
+
+// DeleteCloudPoolsPool deletes a CloudPools pool.
+func DeleteCloudPoolsPool(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.CloudApi.DeleteCloudv4CloudPool(ctx, id).Execute()
+	return err
+}
+
+// GetCloudPoolsPoolState reads a CloudPools pool and maps it to the resource model.
+func GetCloudPoolsPoolState(ctx context.Context, client *client.Client, id string, state *models.CloudPoolsPoolResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.GetCloudv4CloudPool(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Pools) == 0 {
+		return fmt.Errorf("could not find CloudPools pool with ID %s", id)
+	}
+	pool := result.Pools[0]
+	accounts, diags := types.ListValueFrom(ctx, types.StringType, pool.Accounts)
+	if diags.HasError() {
+		return fmt.Errorf("could not map the accounts of the pool")
+	}
+	state.ID = types.StringValue(pool.GetId())
+	state.Name = types.StringValue(pool.GetName())
+	state.Type = types.StringValue(pool.GetType())
+	state.Accounts = accounts
+	state.Description = types.StringValue(pool.GetDescription())
+	state.Vendor = types.StringValue(pool.GetVendor())
+	state.State = types.StringValue(pool.GetState())
+	state.BirthClusterID = types.StringValue(pool.GetBirthClusterId())
+	return nil
+}
+
+// UpdateCloudPoolsSettings updates the default values of the cloud policies.
+func UpdateCloudPoolsSettings(ctx context.Context, client *client.Client, plan *models.CloudPoolsSettingsResourceModel) error {
+	defaults := &cloudPolicyDefaultsJSON{
+		ArchiveSnapshotFiles:       GetKnownBoolPointer(plan.ArchiveSnapshotFiles),
+		Compression:                GetKnownBoolPointer(plan.Compression),
+		DataRetention:              GetKnownInt64Pointer(plan.DataRetention),
+		Encryption:                 GetKnownBoolPointer(plan.Encryption),
+		FullBackupRetention:        GetKnownInt64Pointer(plan.FullBackupRetention),
+		IncrementalBackupRetention: GetKnownInt64Pointer(plan.IncrementalBackupRetention),
+		WritebackFrequency:         GetKnownInt64Pointer(plan.WritebackFrequency),
+	}
+	cache := &cloudPolicyCacheJSON{
+		Expiration: GetKnownInt64Pointer(plan.CacheExpiration),
+		ReadAhead:  GetKnownStringPointer(plan.CacheReadAhead),
+		Type:       GetKnownStringPointer(plan.CacheType),
+	}
+	if *cache != (cloudPolicyCacheJSON{}) {
+		defaults.Cache = cache
+	}
+
+	var toUpdate powerscale.V4CloudSettingsExtended
+	if err := convertJSON(&cloudSettingsJSON{CloudPolicyDefaults: defaults}, &toUpdate); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.CloudApi.UpdateCloudv4CloudSettings(ctx).V4CloudSettings(toUpdate).Execute()
+	return err
+}
+
+// GetCloudPoolsSettingsState reads the CloudPools settings and maps the default values of the cloud policies to the resource model.
+func GetCloudPoolsSettingsState(ctx context.Context, client *client.Client, state *models.CloudPoolsSettingsResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.CloudApi.GetCloudv4CloudSettings(ctx).Execute()
+	if err != nil {
+		return err
+	}
+	var settings cloudSettingsJSON
+	if err := convertJSON(result.Settings, &settings); err != nil {
+		return err
+	}
+	defaults := settings.CloudPolicyDefaults
+	if defaults == nil {
+		defaults = &cloudPolicyDefaultsJSON{}
+	}
+	cache := defaults.Cache
+	if cache == nil {
+		cache = &cloudPolicyCacheJSON{}
+	}
+	state.ID = types.StringValue("cloudpools_settings")
+	state.ArchiveSnapshotFiles = types.BoolPointerValue(defaults.ArchiveSnapshotFiles)
+	state.CacheExpiration = types.Int64PointerValue(cache.Expiration)
+	state.CacheReadAhead = types.StringPointerValue(cache.ReadAhead)
+	state.CacheType = types.StringPointerValue(cache.Type)
+	state.Compression = types.BoolPointerValue(defaults.Compression)
+	state.DataRetention = types.Int64PointerValue(defaults.DataRetention)
+	state.Encryption = types.BoolPointerValue(defaults.Encryption)
+	state.FullBackupRetention = types.Int64PointerValue(defaults.FullBackupRetention)
+	state.IncrementalBackupRetention = types.Int64PointerValue(defaults.IncrementalBackupRetention)
+	state.WritebackFrequency = types.Int64PointerValue(defaults.WritebackFrequency)
+	return nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dedupeJobType is the Job Engine job type which deduplicates the dedupe paths.
+const dedupeJobType = "Dedupe"
+
+// GetDedupeSettings retrieve dedupe settings.
+func GetDedupeSettings(ctx context.Context, client *client.Client) (*powerscale.V1DedupeSettings, error) {
+	settings, _, err := client.PscaleOpenAPIClient.DedupeApi.GetDedupev1DedupeSettings(ctx).Execute()
+	return settings, err
+}
+
+// UpdateDedupeSettings update dedupe settings, and enables or disables the Dedupe job.
+func UpdateDedupeSettings(ctx context.Context, client *client.Client, plan *models.DedupeSettingsResourceModel) error {
+	var toUpdate powerscale.V1DedupeSettingsExtended
+	if err := ReadFromState(ctx, plan, &toUpdate); err != nil {
+		return err
+	}
+	if _, err := client.PscaleOpenAPIClient.DedupeApi.UpdateDedupev1DedupeSettings(ctx).V1DedupeSettings(toUpdate).Execute(); err != nil {
+		return err
+	}
+	if plan.DedupeEnabled.IsNull() || plan.DedupeEnabled.IsUnknown() {
+		return nil
+	}
+	return UpdateJobType(ctx, client, &models.JobTypeResourceModel{
+		ID:       types.StringValue(dedupeJobType),
+		Enabled:  plan.DedupeEnabled,
+		Priority: types.Int64Null(),
+		Policy:   types.StringNull(),
+		Schedule: types.StringNull(),
+	})
+}
+
+// GetDedupeSettingsState reads the dedupe settings and the state of the Dedupe job, and maps them to the resource model.
+func GetDedupeSettingsState(ctx context.Context, client *client.Client, state *models.DedupeSettingsResourceModel) error {
+	settings, err := GetDedupeSettings(ctx, client)
+	if err != nil {
+		return err
+	}
+	if err := CopyFields(ctx, settings.Settings, state); err != nil {
+		return err
+	}
+	var jobType models.JobTypeResourceModel
+	if err := GetJobTypeState(ctx, client, dedupeJobType, &jobType); err != nil {
+		return err
+	}
+	state.ID = types.StringValue("dedupe_settings")
+	state.DedupeEnabled = jobType.Enabled
+	return nil
+}
+
+// GetDedupeSummary retrieve the space savings of deduplication.
+func GetDedupeSummary(ctx context.Context, client *client.Client) (*powerscale.V1DedupeDedupeSummary, error) {
+	summary, _, err := client.PscaleOpenAPIClient.DedupeApi.GetDedupev1DedupeDedupeSummary(ctx).Execute()
+	return summary, err
+}
+
+// NewDedupeSummaryDataSource creates a new DedupeSummaryDataSourceModel from the dedupe summary.
+func NewDedupeSummaryDataSource(ctx context.Context, summary *powerscale.V1DedupeDedupeSummary) (*models.DedupeSummaryDataSourceModel, error) {
+	var dsSummary models.DedupeSummaryModel
+	if err := CopyFields(ctx, summary.Summary, &dsSummary); err != nil {
+		return nil, err
+	}
+	return &models.DedupeSummaryDataSourceModel{
+		ID:      types.StringValue("dedupe_summary_datasource"),
+		Summary: &dsSummary,
+	}, nil
+}
+
+// ListDedupeReports list dedupe reports.
+func ListDedupeReports(ctx context.Context, client *client.Client, filter *models.DedupeReportFilterType) ([]powerscale.V1DedupeReport, error) {
+	listParam := client.PscaleOpenAPIClient.DedupeApi.ListDedupev1DedupeReports(ctx)
+	if filter != nil {
+		if !filter.JobID.IsNull() {
+			listParam = listParam.JobId(int32(filter.JobID.ValueInt64()))
+		}
+		if !filter.JobType.IsNull() {
+			listParam = listParam.JobType(filter.JobType.ValueString())
+		}
+		if !filter.Begin.IsNull() {
+			listParam = listParam.Begin(int32(filter.Begin.ValueInt64()))
+		}
+		if !filter.End.IsNull() {
+			listParam = listParam.End(int32(filter.End.ValueInt64()))
+		}
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	reports := resp.Reports
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.DedupeApi.ListDedupev1DedupeReports(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, resp.Reports...)
+	}
+	return reports, nil
+}
+
+// NewDedupeReportDataSource creates a new DedupeReportDataSourceModel from the dedupe reports.
+func NewDedupeReportDataSource(ctx context.Context, reports []powerscale.V1DedupeReport) (*models.DedupeReportDataSourceModel, error) {
+	var err error
+	dsReports := make([]models.DedupeReportModel, len(reports))
+	for i := range reports {
+		err = errors.Join(err, CopyFields(ctx, &reports[i], &dsReports[i]))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.DedupeReportDataSourceModel{
+		ID:            types.StringValue("dedupe_report_datasource"),
+		DedupeReports: dsReports,
+	}, nil
+}
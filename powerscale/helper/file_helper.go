@@ -0,0 +1,235 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"crypto/sha256"
+	powerscale "dell/powerscale-go-client"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FileInfo holds the metadata of a file.
+type FileInfo struct {
+	Owner        string
+	Group        string
+	Mode         string
+	Size         int64
+	LastModified string
+	ContentType  string
+	Type         string
+}
+
+// GetNamespacePath converts an absolute /ifs path to the path used by the namespace API.
+func GetNamespacePath(path string) string {
+	return strings.TrimLeft(filepath.ToSlash(filepath.Clean(path)), "/")
+}
+
+// GetPlannedFileContent returns the content planned for the file, from the content, the base64 content or the source file.
+func GetPlannedFileContent(plan *models.FileResourceModel) ([]byte, error) {
+	switch {
+	case !plan.ContentBase64.IsNull():
+		content, err := base64.StdEncoding.DecodeString(plan.ContentBase64.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("content_base64 is not valid base64: %s", err.Error())
+		}
+		return content, nil
+	case !plan.Source.IsNull():
+		content, err := os.ReadFile(filepath.Clean(plan.Source.ValueString()))
+		if err != nil {
+			return nil, fmt.Errorf("could not read source file: %s", err.Error())
+		}
+		return content, nil
+	default:
+		return []byte(plan.Content.ValueString()), nil
+	}
+}
+
+// FileContentSha256 returns the hex encoded SHA-256 checksum of the content.
+func FileContentSha256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// WriteFile creates the file or replaces its content.
+func WriteFile(ctx context.Context, client *client.Client, plan *models.FileResourceModel, content []byte, overwrite bool) error {
+	createParam := client.PscaleOpenAPIClient.NamespaceApi.CreateFile(ctx, GetNamespacePath(plan.Path.ValueString()))
+	createParam = createParam.XIsiIfsTargetType("object")
+	createParam = createParam.Overwrite(overwrite)
+	if !plan.AccessControl.IsNull() && !plan.AccessControl.IsUnknown() && plan.AccessControl.ValueString() != "" {
+		createParam = createParam.XIsiIfsAccessControl(plan.AccessControl.ValueString())
+	}
+	_, _, err := createParam.FileContents(string(content)).Execute()
+	return err
+}
+
+// ReadFileContent reads the content of a file.
+func ReadFileContent(ctx context.Context, client *client.Client, path string) ([]byte, error) {
+	_, httpResp, err := client.PscaleOpenAPIClient.NamespaceApi.GetFileContents(ctx, GetNamespacePath(path)).Execute()
+	if err != nil {
+		return nil, err
+	}
+	// the raw content is kept in the response body, whatever the content type of the file
+	return io.ReadAll(httpResp.Body)
+}
+
+// DeleteFile deletes a file.
+func DeleteFile(ctx context.Context, client *client.Client, path string) error {
+	_, _, err := client.PscaleOpenAPIClient.NamespaceApi.DeleteFile(ctx, GetNamespacePath(path)).Execute()
+	return err
+}
+
+// GetFileInfo reads the metadata and the ownership of a file.
+// A file which does not exist is reported as nil.
+func GetFileInfo(ctx context.Context, client *client.Client, path string) (*FileInfo, error) {
+	namespacePath := GetNamespacePath(path)
+	fileACL, httpResp, err := client.PscaleOpenAPIClient.NamespaceApi.GetAcl(ctx, namespacePath).Acl(true).Nsaccess(true).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	info := &FileInfo{
+		Owner: fileACL.Owner.GetName(),
+		Group: fileACL.Group.GetName(),
+		Mode:  fileACL.GetMode(),
+	}
+
+	// the metadata of a file and of a directory are read the same way
+	meta, err := GetDirectoryMetadata(ctx, client, namespacePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range meta.Attrs {
+		switch attr.GetName() {
+		case "size":
+			info.Size, _ = strconv.ParseInt(attr.GetValue(), 10, 64)
+		case "last_modified":
+			info.LastModified = attr.GetValue()
+		case "content_type":
+			info.ContentType = attr.GetValue()
+		case "type":
+			info.Type = attr.GetValue()
+		}
+	}
+	return info, nil
+}
+
+// UpdateFileOwnership updates the owner, the group and the POSIX mode of a file, when they differ from the state.
+func UpdateFileOwnership(ctx context.Context, client *client.Client, plan *models.FileResourceModel, state *models.FileResourceModel) error {
+	namespaceACL := *powerscale.NewNamespaceAcl()
+	changed := false
+	if !plan.Owner.IsNull() && !plan.Owner.IsUnknown() && !plan.Owner.Equal(state.Owner) {
+		owner := *powerscale.NewMemberObject()
+		owner.Name = plan.Owner.ValueStringPointer()
+		owner.SetType("user")
+		namespaceACL.SetOwner(owner)
+		changed = true
+	}
+	if !plan.Group.IsNull() && !plan.Group.IsUnknown() && !plan.Group.Equal(state.Group) {
+		group := *powerscale.NewMemberObject()
+		group.Name = plan.Group.ValueStringPointer()
+		group.SetType("group")
+		namespaceACL.SetGroup(group)
+		changed = true
+	}
+	if !plan.AccessControl.IsNull() && !plan.AccessControl.IsUnknown() && !plan.AccessControl.Equal(state.AccessControl) {
+		newMode, newAuthoritative := getNewAccessControlParams(plan.AccessControl.ValueString())
+		if newAuthoritative == acl {
+			return fmt.Errorf("modifying the access control of a file is only supported in POSIX format, but got: %s", plan.AccessControl.ValueString())
+		}
+		namespaceACL.SetMode(newMode)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	namespaceACL.SetAuthoritative(mode)
+	_, _, err := client.PscaleOpenAPIClient.NamespaceApi.SetAcl(ctx, GetNamespacePath(plan.Path.ValueString())).Acl(true).NamespaceAcl(namespaceACL).Execute()
+	return err
+}
+
+// GetFileState reads a file and maps it to the resource model.
+// The configured content is kept, and the checksum of the content on the cluster is used to detect changes.
+// It reports whether the file exists.
+func GetFileState(ctx context.Context, client *client.Client, state *models.FileResourceModel) (bool, error) {
+	info, err := GetFileInfo(ctx, client, state.Path.ValueString())
+	if err != nil || info == nil {
+		return false, err
+	}
+	content, err := ReadFileContent(ctx, client, state.Path.ValueString())
+	if err != nil {
+		return false, err
+	}
+	state.ID = state.Path
+	state.ContentSha256 = types.StringValue(FileContentSha256(content))
+	state.Owner = types.StringValue(info.Owner)
+	state.Group = types.StringValue(info.Group)
+	state.Mode = types.StringValue(info.Mode)
+	state.Size = types.Int64Value(info.Size)
+	state.LastModified = types.StringValue(info.LastModified)
+	if _, authoritative := getNewAccessControlParams(state.AccessControl.ValueString()); authoritative == mode && !state.AccessControl.IsNull() {
+		// a POSIX mode changed outside of terraform is reported as a change of the access control
+		state.AccessControl = types.StringValue(info.Mode)
+	}
+	return true, nil
+}
+
+// GetFileDataSourceState reads a file and maps it to the data source model.
+func GetFileDataSourceState(ctx context.Context, client *client.Client, state *models.FileDataSourceModel) error {
+	info, err := GetFileInfo(ctx, client, state.Path.ValueString())
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return fmt.Errorf("file %s does not exist", state.Path.ValueString())
+	}
+	state.ID = state.Path
+	state.Owner = types.StringValue(info.Owner)
+	state.Group = types.StringValue(info.Group)
+	state.Mode = types.StringValue(info.Mode)
+	state.Size = types.Int64Value(info.Size)
+	state.LastModified = types.StringValue(info.LastModified)
+	state.ContentType = types.StringValue(info.ContentType)
+	state.Content = types.StringNull()
+	state.ContentBase64 = types.StringNull()
+	state.ContentSha256 = types.StringNull()
+	if state.IncludeContent.ValueBool() {
+		content, err := ReadFileContent(ctx, client, state.Path.ValueString())
+		if err != nil {
+			return err
+		}
+		state.Content = types.StringValue(string(content))
+		state.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content))
+		state.ContentSha256 = types.StringValue(FileContentSha256(content))
+	}
+	return nil
+}
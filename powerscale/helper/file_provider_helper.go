@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateFileProvider creates a file provider.
+func CreateFileProvider(ctx context.Context, client *client.Client, plan *models.FileProviderResourceModel) error {
+	createBody := powerscale.V1ProvidersFileItem{}
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return err
+	}
+	_, _, err := client.PscaleOpenAPIClient.AuthApi.CreateAuthv1ProvidersFileItem(ctx).V1ProvidersFileItem(createBody).Execute()
+	return err
+}
+
+// UpdateFileProvider updates the file provider with the planned settings.
+func UpdateFileProvider(ctx context.Context, client *client.Client, plan *models.FileProviderResourceModel) error {
+	editBody := powerscale.V1ProvidersFileIdParams{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1ProvidersFileById(ctx, plan.Name.ValueString()).V1ProvidersFileIdParams(editBody).Execute()
+	return err
+}
+
+// DeleteFileProvider deletes a file provider.
+func DeleteFileProvider(ctx context.Context, client *client.Client, name string) error {
+	_, err := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv1ProvidersFileById(ctx, name).Execute()
+	return err
+}
+
+// getFileProvider reads the file provider with the given name.
+func getFileProvider(ctx context.Context, client *client.Client, name string) (*powerscale.V1ProvidersFileFileItem, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1ProvidersFileById(ctx, name).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.File) == 0 {
+		return nil, fmt.Errorf("could not find file provider %s", name)
+	}
+	return &result.File[0], nil
+}
+
+// GetFileProviderState reads the file provider with the given name and maps it to the resource model.
+func GetFileProviderState(ctx context.Context, client *client.Client, name string, state *models.FileProviderResourceModel) error {
+	provider, err := getFileProvider(ctx, client, name)
+	if err != nil {
+		return err
+	}
+	if err := CopyFields(ctx, provider, state); err != nil {
+		return err
+	}
+	state.ID = types.StringValue(provider.GetName())
+	return nil
+}
+
+// ListFileProviders lists the file providers of the cluster.
+func ListFileProviders(ctx context.Context, client *client.Client) ([]powerscale.V1ProvidersFileFileItem, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.ListAuthv1ProvidersFile(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return result.File, nil
+}
+
+// NewFileProviderDataSource creates a new FileProviderDataSourceModel from the file providers matching the filter.
+func NewFileProviderDataSource(ctx context.Context, providers []powerscale.V1ProvidersFileFileItem, filter *models.FileProviderFilterType) (*models.FileProviderDataSourceModel, error) {
+	var filtered []powerscale.V1ProvidersFileFileItem
+	if filter != nil && len(filter.Names) > 0 {
+		for _, name := range filter.Names {
+			found := false
+			for _, provider := range providers {
+				if provider.GetName() == name.ValueString() {
+					filtered = append(filtered, provider)
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("could not find file provider %s", name.ValueString())
+			}
+		}
+	} else {
+		filtered = providers
+	}
+
+	var err error
+	dsProviders := make([]models.FileProviderDetailModel, len(filtered))
+	for i := range filtered {
+		err = errors.Join(err, CopyFields(ctx, &filtered[i], &dsProviders[i]))
+		dsProviders[i].ID = types.StringValue(filtered[i].GetName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.FileProviderDataSourceModel{
+		ID:            types.StringValue("file_provider_datasource"),
+		FileProviders: dsProviders,
+	}, nil
+}
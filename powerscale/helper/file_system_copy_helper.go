@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CopyFileSystem copies the source directory or file to the target path, and returns the type of the source.
+func CopyFileSystem(ctx context.Context, client *client.Client, plan *models.FileSystemCopyResourceModel) (string, error) {
+	sourceInfo, err := GetFileInfo(ctx, client, plan.SourcePath.ValueString())
+	if err != nil {
+		return "", err
+	}
+	if sourceInfo == nil {
+		return "", fmt.Errorf("source %s does not exist", plan.SourcePath.ValueString())
+	}
+	copySource := "/namespace/" + GetNamespacePath(plan.SourcePath.ValueString())
+	targetPath := GetNamespacePath(plan.TargetPath.ValueString())
+
+	if sourceInfo.Type == "container" {
+		if plan.Clone.ValueBool() || !plan.Snapshot.IsNull() {
+			return "", fmt.Errorf("clone and snapshot are only supported when copying a file, but %s is a directory", plan.SourcePath.ValueString())
+		}
+		copyParam := client.PscaleOpenAPIClient.NamespaceApi.CopyDirectory(ctx, targetPath)
+		copyParam = copyParam.XIsiIfsCopySource(copySource)
+		copyParam = copyParam.Merge(plan.Merge.ValueBool())
+		copyParam = copyParam.Overwrite(plan.Overwrite.ValueBool())
+		copyParam = copyParam.Continue_(plan.ContinueOnError.ValueBool())
+		_, _, err = copyParam.Execute()
+		return sourceInfo.Type, err
+	}
+
+	if plan.Merge.ValueBool() {
+		return "", fmt.Errorf("merge is only supported when copying a directory, but %s is a file", plan.SourcePath.ValueString())
+	}
+	copyParam := client.PscaleOpenAPIClient.NamespaceApi.CopyFile(ctx, targetPath)
+	copyParam = copyParam.XIsiIfsCopySource(copySource)
+	copyParam = copyParam.Overwrite(plan.Overwrite.ValueBool())
+	copyParam = copyParam.Clone(plan.Clone.ValueBool())
+	if !plan.Snapshot.IsNull() && !plan.Snapshot.IsUnknown() {
+		copyParam = copyParam.Snapshot(plan.Snapshot.ValueString())
+	}
+	_, _, err = copyParam.Execute()
+	return sourceInfo.Type, err
+}
+
+// GetFileSystemCopyState reads the copy and reports whether it still exists.
+func GetFileSystemCopyState(ctx context.Context, client *client.Client, state *models.FileSystemCopyResourceModel) (bool, error) {
+	info, err := GetFileInfo(ctx, client, state.TargetPath.ValueString())
+	if err != nil || info == nil {
+		return false, err
+	}
+	state.ID = state.TargetPath
+	state.Type = types.StringValue(info.Type)
+	return true, nil
+}
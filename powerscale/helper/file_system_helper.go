@@ -25,6 +25,7 @@ import (
 	"io"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/constants"
@@ -90,6 +91,13 @@ func GetDirectoryMetadata(ctx context.Context, client *client.Client, directory
 						Namespace: &nameSpace,
 						Value:     &newVal,
 					})
+				case float64:
+					newVal := strconv.FormatFloat(val, 'f', -1, 64)
+					newResult = append(newResult, powerscale.NamespaceMetadataListAttrsInner{
+						Name:      &name,
+						Namespace: &nameSpace,
+						Value:     &newVal,
+					})
 				case bool:
 					newVal := fmt.Sprintf("%v", &val)
 					newResult = append(newResult, powerscale.NamespaceMetadataListAttrsInner{
@@ -128,7 +136,19 @@ func GetDirectoryQuota(ctx context.Context, client *client.Client, directory str
 // GetDirectorySnapshots returns the filesystem snapshots.
 func GetDirectorySnapshots(ctx context.Context, client *client.Client) (*powerscale.V1SnapshotSnapshots, error) {
 	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx).Execute()
-	return result, err
+	if err != nil {
+		return result, err
+	}
+	// Pagination
+	for result.Resume != nil {
+		resultAdd, _, errAdd := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx).Resume(*result.Resume).Execute()
+		if errAdd != nil {
+			return result, errAdd
+		}
+		result.Resume = resultAdd.Resume
+		result.Snapshots = append(result.Snapshots, resultAdd.Snapshots...)
+	}
+	return result, nil
 }
 
 // FilterPowerScaleSnapshots returns the filtered list of filesystem snapshots.
@@ -289,6 +309,14 @@ func UpdateFileSystemResourceState(ctx context.Context, plan *models.FileSystemR
 			plan.Type = types.StringValue(*attribute.Value)
 		case "create_time":
 			plan.CreationTime = types.StringValue(*attribute.Value)
+		case "requested_protection":
+			plan.RequestedProtection = types.StringValue(*attribute.Value)
+		case "access_pattern":
+			plan.AccessPattern = types.StringValue(*attribute.Value)
+		case "ssd_strategy":
+			plan.SSDStrategy = types.StringValue(*attribute.Value)
+		case "disk_pool_policy":
+			plan.DiskPoolPolicy = types.StringValue(*attribute.Value)
 		}
 	}
 	if plan.Type.IsUnknown() {
@@ -297,6 +325,11 @@ func UpdateFileSystemResourceState(ctx context.Context, plan *models.FileSystemR
 	if plan.CreationTime.IsUnknown() {
 		plan.CreationTime = types.StringNull()
 	}
+	for _, attribute := range []*types.String{&plan.RequestedProtection, &plan.AccessPattern, &plan.SSDStrategy, &plan.DiskPoolPolicy} {
+		if attribute.IsUnknown() {
+			*attribute = types.StringNull()
+		}
+	}
 	if owner, ok := acl.GetOwnerOk(); ok {
 		// setting owner type
 		// - if owner type is unknown, will set it to the actual type in response.
@@ -461,6 +494,14 @@ func UpdateFileSystemResourceImportState(ctx context.Context, id string, state *
 			state.Type = types.StringValue(*attribute.Value)
 		case "create_time":
 			state.CreationTime = types.StringValue(*attribute.Value)
+		case "requested_protection":
+			state.RequestedProtection = types.StringValue(*attribute.Value)
+		case "access_pattern":
+			state.AccessPattern = types.StringValue(*attribute.Value)
+		case "ssd_strategy":
+			state.SSDStrategy = types.StringValue(*attribute.Value)
+		case "disk_pool_policy":
+			state.DiskPoolPolicy = types.StringValue(*attribute.Value)
 		}
 	}
 
@@ -500,6 +541,7 @@ func UpdateFileSystemResourceImportState(ctx context.Context, id string, state *
 	state.DirectoryPath = types.StringValue("/" + dir)
 	state.Overwrite = types.BoolValue(false)
 	state.Recursive = types.BoolValue(true)
+	state.DeleteMode = types.StringValue(FileSystemDeleteModeFailIfNotEmpty)
 	state.AccessControl = state.Mode
 }
 
@@ -514,6 +556,16 @@ func GetDirectoryPath(dirPath string, dirName string) string {
 const acl = "acl"
 const mode = "mode"
 
+// Delete modes of the file system.
+const (
+	// FileSystemDeleteModeFailIfNotEmpty deletes the directory only when it is empty.
+	FileSystemDeleteModeFailIfNotEmpty = "fail_if_not_empty"
+	// FileSystemDeleteModeRecursive deletes the directory along with its content.
+	FileSystemDeleteModeRecursive = "recursive"
+	// FileSystemDeleteModeRetain leaves the directory on the cluster and only removes it from the state.
+	FileSystemDeleteModeRetain = "retain"
+)
+
 // UpdateFileSystemOwnerAndGroup Updates the file system Owner and Group.
 func UpdateFileSystemOwnerAndGroup(ctx context.Context, client *client.Client, dirPath string, plan *models.FileSystemResource, state *models.FileSystemResource) error {
 	// Update Owner / Group if modified
@@ -611,13 +663,173 @@ func ExecuteCreate(reqCreate powerscale.ApiCreateDirectoryRequest) (map[string]i
 	return reqCreate.Execute()
 }
 
-// DeleteFileSystem Deletes a filesystem.
-func DeleteFileSystem(ctx context.Context, client *client.Client, dirPath string) error {
-
-	if _, _, err := client.PscaleOpenAPIClient.NamespaceApi.DeleteDirectory(ctx, dirPath).Execute(); err != nil {
+// DeleteFileSystem Deletes a filesystem, along with its content when recursive is set.
+func DeleteFileSystem(ctx context.Context, client *client.Client, dirPath string, recursive bool) error {
+	deleteParam := client.PscaleOpenAPIClient.NamespaceApi.DeleteDirectory(ctx, dirPath)
+	if recursive {
+		deleteParam = deleteParam.Recursive(true)
+	}
+	if _, _, err := deleteParam.Execute(); err != nil {
 		errStr := constants.DeleteFileSystemErrorMsg
 		message := GetErrorString(err, errStr)
 		return fmt.Errorf("error deleting filesystem - %s : %s", dirPath, message)
 	}
 	return nil
 }
+
+// UpdateFileSystemAttributes sets the protection, access pattern, SSD strategy and disk pool policy of a filesystem
+// through the namespace metadata, when they differ from the state.
+func UpdateFileSystemAttributes(ctx context.Context, client *client.Client, dirPath string, plan *models.FileSystemResource, state *models.FileSystemResource) error {
+	attributes := []struct {
+		name  string
+		plan  types.String
+		state types.String
+	}{
+		{"requested_protection", plan.RequestedProtection, state.RequestedProtection},
+		{"access_pattern", plan.AccessPattern, state.AccessPattern},
+		{"ssd_strategy", plan.SSDStrategy, state.SSDStrategy},
+		{"disk_pool_policy", plan.DiskPoolPolicy, state.DiskPoolPolicy},
+	}
+	var attrs []powerscale.NamespaceMetadataAttrsInner
+	for _, attribute := range attributes {
+		if attribute.plan.IsNull() || attribute.plan.IsUnknown() || attribute.plan.Equal(attribute.state) {
+			continue
+		}
+		metadataAttr := powerscale.NamespaceMetadataAttrsInner{}
+		metadataAttr.SetName(attribute.name)
+		metadataAttr.SetNamespace("system")
+		metadataAttr.SetOp("update")
+		metadataAttr.SetValue(attribute.plan.ValueString())
+		attrs = append(attrs, metadataAttr)
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	metadata := *powerscale.NewNamespaceMetadata()
+	metadata.SetAction("update")
+	metadata.Attrs = attrs
+	if _, err := client.PscaleOpenAPIClient.NamespaceApi.SetDirectoryMetadata(ctx, dirPath).Metadata(true).NamespaceMetadata(metadata).Execute(); err != nil {
+		errStr := constants.SetFileSystemAttributesErrorMsg
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error setting the attributes of filesystem - %s : %s", dirPath, message)
+	}
+	return nil
+}
+
+// MoveFileSystem moves or renames a filesystem along with its content, both paths being relative to the namespace.
+func MoveFileSystem(ctx context.Context, client *client.Client, sourcePath string, targetPath string) error {
+	moveParam := client.PscaleOpenAPIClient.NamespaceApi.MoveDirectory(ctx, sourcePath)
+	moveParam = moveParam.XIsiIfsSetLocation("/namespace/" + targetPath)
+	if _, _, err := moveParam.Execute(); err != nil {
+		errStr := constants.UpdateFileSystemErrorMsg
+		message := GetErrorString(err, errStr)
+		return fmt.Errorf("error moving filesystem %s to %s : %s", sourcePath, targetPath, message)
+	}
+	return nil
+}
+
+// IsDirectoryEmpty reports whether a directory has no children.
+func IsDirectoryEmpty(ctx context.Context, client *client.Client, dirPath string) (bool, error) {
+	result, _, err := client.PscaleOpenAPIClient.NamespaceApi.GetDirectoryContents(ctx, dirPath).Limit(1).Execute()
+	if err != nil {
+		return false, err
+	}
+	return len(result.Children) == 0, nil
+}
+
+// isSameOrUnderPath reports whether path is the directory itself or one of its descendants.
+func isSameOrUnderPath(path string, directory string) bool {
+	path = strings.TrimRight(path, "/")
+	return path == directory || strings.HasPrefix(path, directory+"/")
+}
+
+// GetFileSystemDeleteBlockers lists the quotas, SMB shares, NFS exports and SyncIQ policies
+// set on the directory or on one of its descendants, which would be broken by deleting it.
+// SyncIQ policies replicating into the directory, as local policies or as target policies of this cluster, block it as well.
+func GetFileSystemDeleteBlockers(ctx context.Context, client *client.Client, dirPath string) ([]string, error) {
+	fullPath := "/" + strings.TrimRight(dirPath, "/")
+	var blockers []string
+
+	quotas, _, err := client.PscaleOpenAPIClient.QuotaApi.ListQuotav12QuotaQuotas(ctx).Path(fullPath).RecursePathChildren(true).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("could not list quotas: %s", GetErrorString(err, ""))
+	}
+	quotaList := quotas.Quotas
+	for quotas.Resume != nil {
+		quotas, _, err = client.PscaleOpenAPIClient.QuotaApi.ListQuotav12QuotaQuotas(ctx).Resume(*quotas.Resume).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("could not list quotas: %s", GetErrorString(err, ""))
+		}
+		quotaList = append(quotaList, quotas.Quotas...)
+	}
+	for _, quota := range quotaList {
+		if isSameOrUnderPath(quota.Path, fullPath) {
+			blockers = append(blockers, fmt.Sprintf("quota %s on %s", quota.Id, quota.Path))
+		}
+	}
+
+	zones, err := GetAllAccessZones(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("could not list access zones: %s", GetErrorString(err, ""))
+	}
+	for _, zone := range zones.Zones {
+		zoneName := zone.GetName()
+		shares, err := ListSmbShares(ctx, client, &models.SmbShareDatasourceFilter{Zone: types.StringValue(zoneName)})
+		if err != nil {
+			return nil, fmt.Errorf("could not list SMB shares of access zone %s: %s", zoneName, GetErrorString(err, ""))
+		}
+		for _, share := range *shares {
+			if isSameOrUnderPath(share.Path, fullPath) {
+				blockers = append(blockers, fmt.Sprintf("SMB share %s (zone %s) on %s", share.Name, zoneName, share.Path))
+			}
+		}
+
+		exports, err := ListNFSExports(ctx, client, &models.NfsExportDatasourceFilter{Zone: types.StringValue(zoneName)})
+		if err != nil {
+			return nil, fmt.Errorf("could not list NFS exports of access zone %s: %s", zoneName, GetErrorString(err, ""))
+		}
+		for _, export := range *exports {
+			for _, exportPath := range export.Paths {
+				if isSameOrUnderPath(exportPath, fullPath) {
+					blockers = append(blockers, fmt.Sprintf("NFS export %d (zone %s) on %s", export.GetId(), zoneName, exportPath))
+					break
+				}
+			}
+		}
+	}
+
+	policies, err := GetAllSyncIQPolicies(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("could not list SyncIQ policies: %s", GetErrorString(err, ""))
+	}
+	for _, policy := range policies.Policies {
+		if isSameOrUnderPath(policy.SourceRootPath, fullPath) {
+			blockers = append(blockers, fmt.Sprintf("SyncIQ policy %s on %s", policy.Name, policy.SourceRootPath))
+		}
+		// a policy replicating to this cluster writes to its target path here
+		if isLocalSyncIQTargetHost(policy.GetTargetHost()) && isSameOrUnderPath(policy.GetTargetPath(), fullPath) {
+			blockers = append(blockers, fmt.Sprintf("SyncIQ policy %s targeting %s", policy.Name, policy.GetTargetPath()))
+		}
+	}
+
+	targetPolicies, err := ListSyncIQTargetPolicies(ctx, client, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not list SyncIQ target policies: %s", GetErrorString(err, ""))
+	}
+	for _, policy := range targetPolicies {
+		if isSameOrUnderPath(policy.GetTargetPath(), fullPath) {
+			blockers = append(blockers, fmt.Sprintf("SyncIQ target policy %s targeting %s", policy.GetName(), policy.GetTargetPath()))
+		}
+	}
+	return blockers, nil
+}
+
+// isLocalSyncIQTargetHost returns true if the target host of a SyncIQ policy is the cluster itself.
+func isLocalSyncIQTargetHost(host string) bool {
+	switch strings.ToLower(host) {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	}
+	return false
+}
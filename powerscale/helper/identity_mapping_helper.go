@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateIdentityMapping creates a mapping from the source to the target persona.
+func CreateIdentityMapping(ctx context.Context, client *client.Client, plan *models.IdentityMappingResourceModel) error {
+	createBody := powerscale.V1MappingIdentity{}
+	createBody.SetSource(plan.Source.ValueString())
+	createBody.SetTarget(plan.Target.ValueString())
+
+	createParam := client.PscaleOpenAPIClient.AuthApi.CreateAuthv1MappingIdentity(ctx).
+		Var2way(plan.TwoWay.ValueBool()).
+		Replace(plan.Replace.ValueBool())
+	if zone := plan.Zone.ValueString(); zone != "" {
+		createParam = createParam.Zone(zone)
+	}
+	_, err := createParam.V1MappingIdentity(createBody).Execute()
+	return err
+}
+
+// DeleteIdentityMapping deletes the mapping from the source to the target persona.
+func DeleteIdentityMapping(ctx context.Context, client *client.Client, state *models.IdentityMappingResourceModel) error {
+	deleteParam := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv1MappingIdentity(ctx, state.Source.ValueString()).
+		Target(state.Target.ValueString()).
+		Var2way(state.TwoWay.ValueBool())
+	if zone := state.Zone.ValueString(); zone != "" {
+		deleteParam = deleteParam.Zone(zone)
+	}
+	_, err := deleteParam.Execute()
+	return err
+}
+
+// GetIdentityMappings reads the mappings of the source persona without allocating new ones.
+func GetIdentityMappings(ctx context.Context, client *client.Client, source, zone string) (*powerscale.V1MappingIdentities, error) {
+	getParam := client.PscaleOpenAPIClient.AuthApi.GetAuthv1MappingIdentity(ctx, source).Nocreate(true)
+	if zone != "" {
+		getParam = getParam.Zone(zone)
+	}
+	result, _, err := getParam.Execute()
+	return result, err
+}
+
+// GetIdentityMappingState reads the mapping from the source to the target persona and maps it to the resource model.
+func GetIdentityMappingState(ctx context.Context, client *client.Client, state *models.IdentityMappingResourceModel) error {
+	source, target := state.Source.ValueString(), state.Target.ValueString()
+	result, err := GetIdentityMappings(ctx, client, source, state.Zone.ValueString())
+	if err != nil {
+		return err
+	}
+	for _, identity := range result.Identities {
+		for _, mapping := range identity.Targets {
+			if strings.EqualFold(mapping.Target.GetId(), target) {
+				state.OnDisk = types.BoolValue(mapping.GetOnDisk())
+				state.Type = types.StringValue(mapping.GetType())
+				state.ID = types.StringValue(identityMappingID(state.Zone.ValueString(), source, target))
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("could not find identity mapping from %s to %s", source, target)
+}
+
+// identityMappingPersonaRegex matches a persona of an identity mapping ID, whose value can not contain ":".
+var identityMappingPersonaRegex = regexp.MustCompile(`^(?i)(SID|UID|GID):[^:\s]+$`)
+
+// identityMappingID builds the ID of an identity mapping, prefixed with the zone when one is set.
+func identityMappingID(zone, source, target string) string {
+	if zone == "" {
+		return source + ":" + target
+	}
+	return zone + ":" + source + ":" + target
+}
+
+// ParseIdentityMappingID splits an identity mapping ID of the form [<zone>:]<source>:<target>,
+// where both personas are of the form <type>:<value> with a type of SID, UID or GID.
+// Neither the zone nor the persona values may contain ":", IDs which cannot be split unambiguously are rejected.
+func ParseIdentityMappingID(id string) (zone, source, target string, err error) {
+	parts := strings.Split(id, ":")
+	switch len(parts) {
+	case 4:
+		source, target = parts[0]+":"+parts[1], parts[2]+":"+parts[3]
+	case 5:
+		zone, source, target = parts[0], parts[1]+":"+parts[2], parts[3]+":"+parts[4]
+		if zone == "" {
+			return "", "", "", fmt.Errorf("invalid identity mapping ID %s, the zone is empty", id)
+		}
+	default:
+		return "", "", "", fmt.Errorf("invalid identity mapping ID %s, expected [<zone>:]<source>:<target> where neither the zone nor the persona values contain \":\", e.g. System:SID:S-1-5-21-1-2-3-1001:UID:2001", id)
+	}
+	for _, persona := range []string{source, target} {
+		if !identityMappingPersonaRegex.MatchString(persona) {
+			return "", "", "", fmt.Errorf("invalid identity mapping ID %s, the persona %s is not of the form SID:<sid>, UID:<uid> or GID:<gid>", id, persona)
+		}
+	}
+	return zone, source, target, nil
+}
+
+// NewIdentityMappingDataSource creates a new IdentityMappingDataSourceModel from the mappings of the source persona.
+func NewIdentityMappingDataSource(result *powerscale.V1MappingIdentities, source, zone types.String) *models.IdentityMappingDataSourceModel {
+	targets := make([]models.IdentityMappingTargetModel, 0)
+	for _, identity := range result.Identities {
+		for _, mapping := range identity.Targets {
+			targets = append(targets, models.IdentityMappingTargetModel{
+				Target: types.StringValue(mapping.Target.GetId()),
+				Name:   types.StringValue(mapping.Target.GetName()),
+				OnDisk: types.BoolValue(mapping.GetOnDisk()),
+				Type:   types.StringValue(mapping.GetType()),
+			})
+		}
+	}
+	return &models.IdentityMappingDataSourceModel{
+		ID:      types.StringValue("identity_mapping_datasource"),
+		Source:  source,
+		Zone:    zone,
+		Targets: targets,
+	}
+}
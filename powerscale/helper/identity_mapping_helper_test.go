@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_ParseIdentityMappingID(t *testing.T) {
+	zone, source, target, err := ParseIdentityMappingID("SID:S-1-5-21-1-2-3-1001:UID:2001")
+	assert.Nil(t, err)
+	assert.Equal(t, "", zone)
+	assert.Equal(t, "SID:S-1-5-21-1-2-3-1001", source)
+	assert.Equal(t, "UID:2001", target)
+
+	zone, source, target, err = ParseIdentityMappingID("System:SID:S-1-5-21-1-2-3-1001:GID:2001")
+	assert.Nil(t, err)
+	assert.Equal(t, "System", zone)
+	assert.Equal(t, "SID:S-1-5-21-1-2-3-1001", source)
+	assert.Equal(t, "GID:2001", target)
+
+	assert.Equal(t, "System:SID:S-1-5-21-1-2-3-1001:GID:2001", identityMappingID(zone, source, target))
+	assert.Equal(t, "SID:S-1-5-21-1-2-3-1001:GID:2001", identityMappingID("", source, target))
+}
+
+func Test_ParseIdentityMappingIDInvalid(t *testing.T) {
+	for _, id := range []string{
+		// zone containing ":"
+		"zone:a:SID:S-1-5-21-1-2-3-1001:UID:2001",
+		// persona value containing ":", which would otherwise be read as a zone
+		"UID:2001:1:GID:2001",
+		"SID:S-1-5-21-1-2-3-1001:UID:2001:1",
+		// empty zone or persona value
+		":SID:S-1-5-21-1-2-3-1001:UID:2001",
+		"SID::UID:2001",
+		// unsupported persona type
+		"System:USER:admin:UID:2001",
+		"SID:S-1-5-21-1-2-3-1001",
+	} {
+		_, _, _, err := ParseIdentityMappingID(id)
+		assert.NotNil(t, err, id)
+	}
+}
@@ -0,0 +1,320 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"cmp"
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// JobPollInterval is the interval between two polls of a running Job Engine job.
+var JobPollInterval = 10 * time.Second
+
+// jobTerminalStates are the job states after which a job will make no further progress.
+var jobTerminalStates = map[string]bool{
+	"succeeded":        true,
+	"failed":           true,
+	"cancelled_user":   true,
+	"cancelled_system": true,
+	"unknown":          true,
+}
+
+// jobFailedStates are the terminal job states which indicate that the job did not succeed.
+var jobFailedStates = map[string]bool{
+	"failed":           true,
+	"cancelled_user":   true,
+	"cancelled_system": true,
+	"unknown":          true,
+}
+
+// CreateJob starts a Job Engine job.
+func CreateJob(ctx context.Context, client *client.Client, job powerscale.V10JobJob) (string, error) {
+	resp, _, err := client.PscaleOpenAPIClient.JobApi.CreateJobv10JobJob(ctx).V10JobJob(job).Execute()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(resp.Id), 10), nil
+}
+
+// GetJobState returns the current state of a Job Engine job.
+// A job which is no longer known to the Job Engine is reported as not found.
+func GetJobState(ctx context.Context, client *client.Client, id string) (state string, found bool, err error) {
+	resp, httpResp, err := client.PscaleOpenAPIClient.JobApi.GetJobv10JobJob(ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if resp == nil || len(resp.Jobs) == 0 {
+		return "", false, nil
+	}
+	return resp.Jobs[0].GetState(), true, nil
+}
+
+// listJobEvents lists the Job Engine events, of a single job when jobID is set.
+func listJobEvents(ctx context.Context, client *client.Client, jobID *int32) ([]powerscale.V10JobEvent, error) {
+	listParam := client.PscaleOpenAPIClient.JobApi.ListJobv10JobEvents(ctx)
+	if jobID != nil {
+		listParam = listParam.JobId(*jobID)
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	events := resp.Events
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.JobApi.ListJobv10JobEvents(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.Events...)
+	}
+	return events, nil
+}
+
+// GetJobFinalState returns the final state of a Job Engine job which is no longer active, as recorded in its events.
+// An empty state is returned when no terminal state has been recorded for the job yet.
+func GetJobFinalState(ctx context.Context, client *client.Client, id string) (string, error) {
+	jobID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return "", fmt.Errorf("invalid job ID %s: %s", id, err.Error())
+	}
+	eventJobID := int32(jobID)
+	events, err := listJobEvents(ctx, client, &eventJobID)
+	if err != nil {
+		return "", err
+	}
+	state, latest := "", int64(-1)
+	for _, event := range events {
+		if jobTerminalStates[event.GetState()] && int64(event.GetTime()) >= latest {
+			state, latest = event.GetState(), int64(event.GetTime())
+		}
+	}
+	return state, nil
+}
+
+// WaitForJob polls the Job Engine job until it reaches a terminal state or the context is done.
+// Once the job is no longer active, its final state is taken from its events.
+// It returns the final job state.
+func WaitForJob(ctx context.Context, client *client.Client, id string) (string, error) {
+	lastState := ""
+	for {
+		state, found, err := GetJobState(ctx, client, id)
+		if err != nil {
+			return lastState, err
+		}
+		if found {
+			lastState = state
+			if jobTerminalStates[state] {
+				return state, nil
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Job %s is in state %s, waiting", id, state))
+		} else {
+			finalState, err := GetJobFinalState(ctx, client, id)
+			if err != nil {
+				return lastState, err
+			}
+			if finalState != "" {
+				return finalState, nil
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Job %s is no longer active, waiting for its final state", id))
+		}
+		select {
+		case <-ctx.Done():
+			return lastState, fmt.Errorf("timed out waiting for job %s, last state: %s", id, lastState)
+		case <-time.After(JobPollInterval):
+		}
+	}
+}
+
+// IsJobStateFailed returns true if the job state indicates that the job did not succeed.
+func IsJobStateFailed(state string) bool {
+	return jobFailedStates[state]
+}
+
+// RunJob starts a Job Engine job from the plan, optionally waits for it to complete and populates the state.
+func RunJob(ctx context.Context, client *client.Client, plan models.JobResourceModel) (models.JobResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+
+	job := powerscale.V10JobJob{
+		Type:   plan.Type.ValueString(),
+		Policy: GetKnownStringPointer(plan.Policy),
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		priority := int32(plan.Priority.ValueInt64())
+		job.Priority = &priority
+	}
+	if !plan.AllowDup.IsNull() && !plan.AllowDup.IsUnknown() {
+		job.AllowDup = plan.AllowDup.ValueBoolPointer()
+	}
+	if !plan.Paths.IsNull() && !plan.Paths.IsUnknown() {
+		diags.Append(plan.Paths.ElementsAs(ctx, &job.Paths, false)...)
+		if diags.HasError() {
+			return state, diags
+		}
+	}
+
+	id, err := CreateJob(ctx, client, job)
+	if err != nil {
+		diags.AddError("Error starting job", GetErrorString(err, "Could not start job with error: "))
+		return state, diags
+	}
+	state.ID = types.StringValue(id)
+	state.State = types.StringValue("running")
+
+	if !plan.WaitForCompletion.ValueBool() {
+		return state, diags
+	}
+
+	jobState, err := WaitForJob(ctx, client, id)
+	if err != nil {
+		diags.AddError("Error waiting for job", GetErrorString(err, "Could not get job state with error: "))
+		return state, diags
+	}
+	state.State = types.StringValue(jobState)
+	if IsJobStateFailed(jobState) {
+		diags.AddError("Job did not succeed",
+			fmt.Sprintf("Job %s of type %s finished in state %s", id, plan.Type.ValueString(), jobState))
+	}
+	return state, diags
+}
+
+// ListJobs lists the Job Engine jobs.
+func ListJobs(ctx context.Context, client *client.Client, filter *models.JobFilterType) ([]powerscale.V10JobJobExtended, error) {
+	listParam := client.PscaleOpenAPIClient.JobApi.ListJobv10JobJobs(ctx)
+	if filter != nil {
+		if !filter.State.IsNull() {
+			listParam = listParam.State(filter.State.ValueString())
+		}
+		if !filter.Sort.IsNull() {
+			listParam = listParam.Sort(filter.Sort.ValueString())
+		}
+		if !filter.Dir.IsNull() {
+			listParam = listParam.Dir(filter.Dir.ValueString())
+		}
+		if !filter.Limit.IsNull() {
+			listParam = listParam.Limit(int32(filter.Limit.ValueInt64()))
+		}
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	jobs := resp.Jobs
+	for resp.Resume != nil && (filter == nil || filter.Limit.IsNull()) {
+		resp, _, err = client.PscaleOpenAPIClient.JobApi.ListJobv10JobJobs(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, resp.Jobs...)
+	}
+	if filter != nil && len(filter.Types) > 0 {
+		jobTypes := make(map[string]bool)
+		for _, jobType := range filter.Types {
+			jobTypes[jobType.ValueString()] = true
+		}
+		jobs = slices.DeleteFunc(jobs, func(j powerscale.V10JobJobExtended) bool { return !jobTypes[j.GetType()] })
+	}
+	return jobs, nil
+}
+
+// ListFinishedJobs lists the finished Job Engine jobs, as recorded in the terminal events of the jobs, most recent first.
+// Only the ID, type, state and end time of a finished job are known. At most maxJobs jobs are returned when maxJobs is positive.
+func ListFinishedJobs(ctx context.Context, client *client.Client, filter *models.JobFilterType, maxJobs int64) ([]models.JobModel, error) {
+	events, err := listJobEvents(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	finished := make(map[int64]models.JobModel)
+	for _, event := range events {
+		if !jobTerminalStates[event.GetState()] {
+			continue
+		}
+		jobID := int64(event.GetJobId())
+		if job, ok := finished[jobID]; ok && job.EndTime.ValueInt64() > int64(event.GetTime()) {
+			continue
+		}
+		finished[jobID] = models.JobModel{
+			ID:      types.Int64Value(jobID),
+			Type:    types.StringValue(event.GetJobType()),
+			State:   types.StringValue(event.GetState()),
+			EndTime: types.Int64Value(int64(event.GetTime())),
+			Paths:   types.ListNull(types.StringType),
+		}
+	}
+	jobTypes := make(map[string]bool)
+	if filter != nil {
+		for _, jobType := range filter.Types {
+			jobTypes[jobType.ValueString()] = true
+		}
+	}
+	jobs := make([]models.JobModel, 0, len(finished))
+	for _, job := range finished {
+		if filter != nil && !filter.State.IsNull() && job.State.ValueString() != filter.State.ValueString() {
+			continue
+		}
+		if len(jobTypes) > 0 && !jobTypes[job.Type.ValueString()] {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	slices.SortFunc(jobs, func(a, b models.JobModel) int {
+		return cmp.Compare(b.EndTime.ValueInt64(), a.EndTime.ValueInt64())
+	})
+	if maxJobs > 0 && int64(len(jobs)) > maxJobs {
+		jobs = jobs[:maxJobs]
+	}
+	return jobs, nil
+}
+
+// NewJobDataSource creates a new JobDataSourceModel from the active jobs, followed by the finished jobs.
+func NewJobDataSource(ctx context.Context, jobs []powerscale.V10JobJobExtended, finished []models.JobModel) (*models.JobDataSourceModel, error) {
+	var err error
+	dsJobs := make([]models.JobModel, len(jobs))
+	for i := range jobs {
+		var item models.JobModel
+		ierr := CopyFields(ctx, &jobs[i], &item)
+		err = errors.Join(err, ierr)
+		if item.Paths.IsNull() {
+			item.Paths = types.ListNull(types.StringType)
+		}
+		dsJobs[i] = item
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.JobDataSourceModel{
+		ID:   types.StringValue("job_datasource"),
+		Jobs: append(dsJobs, finished...),
+	}, nil
+}
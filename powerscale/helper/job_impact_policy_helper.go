@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JobImpactPolicyIntervalAttrTypes returns the attribute types of an impact policy interval.
+func JobImpactPolicyIntervalAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"begin":  types.StringType,
+		"end":    types.StringType,
+		"impact": types.StringType,
+	}
+}
+
+// buildJobImpactPolicyIntervals converts the planned intervals to the API representation.
+func buildJobImpactPolicyIntervals(ctx context.Context, plan *models.JobImpactPolicyResourceModel) ([]powerscale.V1JobPolicyInterval, error) {
+	var intervals []models.JobImpactPolicyIntervalModel
+	if diags := plan.Intervals.ElementsAs(ctx, &intervals, false); diags.HasError() {
+		return nil, fmt.Errorf("could not read the intervals of job impact policy %s", plan.Name.ValueString())
+	}
+	result := make([]powerscale.V1JobPolicyInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		result = append(result, powerscale.V1JobPolicyInterval{
+			Begin:  interval.Begin.ValueString(),
+			End:    interval.End.ValueString(),
+			Impact: interval.Impact.ValueString(),
+		})
+	}
+	return result, nil
+}
+
+// CreateJobImpactPolicy creates a Job Engine impact policy.
+func CreateJobImpactPolicy(ctx context.Context, client *client.Client, plan *models.JobImpactPolicyResourceModel) (string, error) {
+	intervals, err := buildJobImpactPolicyIntervals(ctx, plan)
+	if err != nil {
+		return "", err
+	}
+	createBody := powerscale.V1JobPolicy{
+		Name:        plan.Name.ValueString(),
+		Description: GetKnownStringPointer(plan.Description),
+		Intervals:   intervals,
+	}
+	result, _, err := client.PscaleOpenAPIClient.JobApi.CreateJobv1JobPolicy(ctx).V1JobPolicy(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.Id, nil
+}
+
+// UpdateJobImpactPolicy updates a Job Engine impact policy.
+func UpdateJobImpactPolicy(ctx context.Context, client *client.Client, id string, plan *models.JobImpactPolicyResourceModel) error {
+	intervals, err := buildJobImpactPolicyIntervals(ctx, plan)
+	if err != nil {
+		return err
+	}
+	editBody := powerscale.V1JobPolicyExtendedExtended{
+		Name:        plan.Name.ValueStringPointer(),
+		Description: GetKnownStringPointer(plan.Description),
+		Intervals:   intervals,
+	}
+	_, err = client.PscaleOpenAPIClient.JobApi.UpdateJobv1JobPolicy(ctx, id).V1JobPolicy(editBody).Execute()
+	return err
+}
+
+// DeleteJobImpactPolicy deletes a Job Engine impact policy.
+func DeleteJobImpactPolicy(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.JobApi.DeleteJobv1JobPolicy(ctx, id).Execute()
+	return err
+}
+
+// GetJobImpactPolicyState reads a Job Engine impact policy and maps it to the resource model.
+func GetJobImpactPolicyState(ctx context.Context, client *client.Client, id string, state *models.JobImpactPolicyResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.JobApi.GetJobv1JobPolicy(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Policies) == 0 {
+		return fmt.Errorf("could not find job impact policy %s", id)
+	}
+	policy := result.Policies[0]
+	intervals := make([]models.JobImpactPolicyIntervalModel, 0, len(policy.Intervals))
+	for _, interval := range policy.Intervals {
+		intervals = append(intervals, models.JobImpactPolicyIntervalModel{
+			Begin:  types.StringValue(interval.GetBegin()),
+			End:    types.StringValue(interval.GetEnd()),
+			Impact: types.StringValue(interval.GetImpact()),
+		})
+	}
+	intervalList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: JobImpactPolicyIntervalAttrTypes()}, intervals)
+	if diags.HasError() {
+		return fmt.Errorf("could not map the intervals of job impact policy %s", id)
+	}
+	state.ID = types.StringValue(policy.GetId())
+	state.Name = types.StringValue(policy.GetName())
+	state.Description = types.StringValue(policy.GetDescription())
+	state.System = types.BoolValue(policy.GetSystem())
+	state.Intervals = intervalList
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateJobType updates the settings of a Job Engine job type.
+func UpdateJobType(ctx context.Context, client *client.Client, plan *models.JobTypeResourceModel) error {
+	editBody := powerscale.V1JobTypeExtendedExtended{}
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		editBody.Enabled = plan.Enabled.ValueBoolPointer()
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		priority := int32(plan.Priority.ValueInt64())
+		editBody.Priority = &priority
+	}
+	editBody.Policy = GetKnownStringPointer(plan.Policy)
+	editBody.Schedule = GetKnownStringPointer(plan.Schedule)
+	_, err := client.PscaleOpenAPIClient.JobApi.UpdateJobv1JobType(ctx, plan.ID.ValueString()).V1JobType(editBody).Execute()
+	return err
+}
+
+// GetJobTypeState reads a Job Engine job type and maps it to the resource model.
+func GetJobTypeState(ctx context.Context, client *client.Client, id string, state *models.JobTypeResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.JobApi.GetJobv1JobType(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Types) == 0 {
+		return fmt.Errorf("could not find job type %s", id)
+	}
+	jobType := result.Types[0]
+	state.ID = types.StringValue(jobType.GetId())
+	state.Enabled = types.BoolValue(jobType.GetEnabled())
+	state.Priority = types.Int64Value(int64(jobType.GetPriority()))
+	state.Policy = types.StringValue(jobType.GetPolicy())
+	state.Schedule = types.StringValue(jobType.GetSchedule())
+	state.Description = types.StringValue(jobType.GetDescription())
+	return nil
+}
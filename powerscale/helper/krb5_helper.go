@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateKrb5Realm creates a Kerberos realm.
+func CreateKrb5Realm(ctx context.Context, client *client.Client, plan *models.Krb5RealmResourceModel) (string, error) {
+	createBody := powerscale.V1SettingsKrb5Realm{}
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return "", err
+	}
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.CreateAuthv1SettingsKrb5Realm(ctx).V1SettingsKrb5Realm(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.GetId(), nil
+}
+
+// UpdateKrb5Realm updates the KDCs, the admin server and the default domain of a Kerberos realm.
+func UpdateKrb5Realm(ctx context.Context, client *client.Client, id string, plan *models.Krb5RealmResourceModel) error {
+	editBody := powerscale.V1SettingsKrb5RealmExtendedExtended{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1SettingsKrb5Realm(ctx, id).V1SettingsKrb5Realm(editBody).Execute()
+	return err
+}
+
+// DeleteKrb5Realm deletes a Kerberos realm.
+func DeleteKrb5Realm(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv1SettingsKrb5Realm(ctx, id).Execute()
+	return err
+}
+
+// GetKrb5RealmState reads a Kerberos realm and maps it to the resource model.
+func GetKrb5RealmState(ctx context.Context, client *client.Client, id string, state *models.Krb5RealmResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1SettingsKrb5Realm(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Realm) == 0 {
+		return fmt.Errorf("could not find Kerberos realm with ID %s", id)
+	}
+	return CopyFields(ctx, &result.Realm[0], state)
+}
+
+// CreateKrb5Domain creates a Kerberos domain.
+func CreateKrb5Domain(ctx context.Context, client *client.Client, plan *models.Krb5DomainResourceModel) (string, error) {
+	createBody := powerscale.V1SettingsKrb5Domain{}
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return "", err
+	}
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.CreateAuthv1SettingsKrb5Domain(ctx).V1SettingsKrb5Domain(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.GetId(), nil
+}
+
+// UpdateKrb5Domain updates the realm a Kerberos domain is mapped to.
+func UpdateKrb5Domain(ctx context.Context, client *client.Client, id string, plan *models.Krb5DomainResourceModel) error {
+	editBody := powerscale.V1SettingsKrb5DomainExtendedExtended{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1SettingsKrb5Domain(ctx, id).V1SettingsKrb5Domain(editBody).Execute()
+	return err
+}
+
+// DeleteKrb5Domain deletes a Kerberos domain.
+func DeleteKrb5Domain(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv1SettingsKrb5Domain(ctx, id).Execute()
+	return err
+}
+
+// GetKrb5DomainState reads a Kerberos domain and maps it to the resource model.
+func GetKrb5DomainState(ctx context.Context, client *client.Client, id string, state *models.Krb5DomainResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1SettingsKrb5Domain(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Domain) == 0 {
+		return fmt.Errorf("could not find Kerberos domain with ID %s", id)
+	}
+	return CopyFields(ctx, &result.Domain[0], state)
+}
+
+// CreateKrb5Provider creates a standalone Kerberos provider.
+func CreateKrb5Provider(ctx context.Context, client *client.Client, plan *models.Krb5ProviderResourceModel) error {
+	createBody := powerscale.V1ProvidersKrb5Item{}
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return err
+	}
+	_, _, err := client.PscaleOpenAPIClient.AuthApi.CreateAuthv1ProvidersKrb5Item(ctx).V1ProvidersKrb5Item(createBody).Execute()
+	return err
+}
+
+// UpdateKrb5Provider updates the credentials and the keytab entries of a Kerberos provider.
+func UpdateKrb5Provider(ctx context.Context, client *client.Client, plan *models.Krb5ProviderResourceModel) error {
+	editBody := powerscale.V1ProvidersKrb5IdParams{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1ProvidersKrb5ById(ctx, plan.Name.ValueString()).V1ProvidersKrb5IdParams(editBody).Execute()
+	return err
+}
+
+// DeleteKrb5Provider deletes a Kerberos provider.
+func DeleteKrb5Provider(ctx context.Context, client *client.Client, name string) error {
+	_, err := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv1ProvidersKrb5ById(ctx, name).Execute()
+	return err
+}
+
+// GetKrb5ProviderState reads a Kerberos provider and maps it to the resource model.
+func GetKrb5ProviderState(ctx context.Context, client *client.Client, name string, state *models.Krb5ProviderResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1ProvidersKrb5ById(ctx, name).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Krb5) == 0 {
+		return fmt.Errorf("could not find Kerberos provider %s", name)
+	}
+	provider := result.Krb5[0]
+	if err := CopyFields(ctx, &provider, state); err != nil {
+		return err
+	}
+	state.ID = types.StringValue(provider.GetName())
+	return nil
+}
+
+// UpdateKrb5Settings updates the Kerberos settings.
+func UpdateKrb5Settings(ctx context.Context, client *client.Client, plan *models.Krb5SettingsResourceModel) error {
+	var toUpdate powerscale.V1SettingsKrb5DefaultsExtended
+	if err := ReadFromState(ctx, plan, &toUpdate); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1SettingsKrb5Defaults(ctx).V1SettingsKrb5Defaults(toUpdate).Execute()
+	return err
+}
+
+// GetKrb5SettingsState reads the Kerberos settings and maps them to the resource model.
+func GetKrb5SettingsState(ctx context.Context, client *client.Client, state *models.Krb5SettingsResourceModel) error {
+	settings, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1SettingsKrb5Defaults(ctx).Execute()
+	if err != nil {
+		return err
+	}
+	if err := CopyFields(ctx, settings.Settings, state); err != nil {
+		return err
+	}
+	state.ID = types.StringValue("krb5_settings")
+	return nil
+}
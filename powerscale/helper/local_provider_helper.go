@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateLocalProvider takes over the existing local provider of an access zone and applies the planned settings.
+func CreateLocalProvider(ctx context.Context, client *client.Client, plan *models.LocalProviderResourceModel) error {
+	if _, err := getLocalProvider(ctx, client, plan.Name.ValueString()); err != nil {
+		return err
+	}
+	return UpdateLocalProvider(ctx, client, plan)
+}
+
+// UpdateLocalProvider updates the local provider with the planned settings.
+func UpdateLocalProvider(ctx context.Context, client *client.Client, plan *models.LocalProviderResourceModel) error {
+	editBody := powerscale.V1ProvidersLocalIdParams{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv1ProvidersLocalById(ctx, plan.Name.ValueString()).V1ProvidersLocalIdParams(editBody).Execute()
+	return err
+}
+
+// getLocalProvider reads the local provider with the given name.
+func getLocalProvider(ctx context.Context, client *client.Client, name string) (*powerscale.V1ProvidersLocalLocalItem, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv1ProvidersLocalById(ctx, name).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.Local) == 0 {
+		return nil, fmt.Errorf("could not find local provider %s", name)
+	}
+	return &result.Local[0], nil
+}
+
+// GetLocalProviderState reads the local provider with the given name and maps it to the resource model.
+func GetLocalProviderState(ctx context.Context, client *client.Client, name string, state *models.LocalProviderResourceModel) error {
+	provider, err := getLocalProvider(ctx, client, name)
+	if err != nil {
+		return err
+	}
+	if err := CopyFields(ctx, provider, state); err != nil {
+		return err
+	}
+	state.ID = types.StringValue(provider.GetName())
+	return nil
+}
+
+// ListLocalProviders lists the local providers of the cluster.
+func ListLocalProviders(ctx context.Context, client *client.Client) ([]powerscale.V1ProvidersLocalLocalItem, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.ListAuthv1ProvidersLocal(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return result.Local, nil
+}
+
+// NewLocalProviderDataSource creates a new LocalProviderDataSourceModel from the local providers matching the filter.
+func NewLocalProviderDataSource(ctx context.Context, providers []powerscale.V1ProvidersLocalLocalItem, filter *models.LocalProviderFilterType) (*models.LocalProviderDataSourceModel, error) {
+	var filtered []powerscale.V1ProvidersLocalLocalItem
+	if filter != nil && len(filter.Names) > 0 {
+		for _, name := range filter.Names {
+			found := false
+			for _, provider := range providers {
+				if provider.GetName() == name.ValueString() {
+					filtered = append(filtered, provider)
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("could not find local provider %s", name.ValueString())
+			}
+		}
+	} else {
+		filtered = providers
+	}
+
+	var err error
+	dsProviders := make([]models.LocalProviderDetailModel, len(filtered))
+	for i := range filtered {
+		err = errors.Join(err, CopyFields(ctx, &filtered[i], &dsProviders[i]))
+		dsProviders[i].ID = types.StringValue(filtered[i].GetName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.LocalProviderDataSourceModel{
+		ID:             types.StringValue("local_provider_datasource"),
+		LocalProviders: dsProviders,
+	}, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateNISProvider creates a NIS provider.
+func CreateNISProvider(ctx context.Context, client *client.Client, plan *models.NISProviderResourceModel) error {
+	createBody := powerscale.V11ProvidersNisItem{}
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return err
+	}
+	_, _, err := client.PscaleOpenAPIClient.AuthApi.CreateAuthv11ProvidersNisItem(ctx).V11ProvidersNisItem(createBody).Execute()
+	return err
+}
+
+// UpdateNISProvider updates the NIS provider with the planned settings.
+func UpdateNISProvider(ctx context.Context, client *client.Client, plan *models.NISProviderResourceModel) error {
+	editBody := powerscale.V11ProvidersNisIdParams{}
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv11ProvidersNisById(ctx, plan.Name.ValueString()).V11ProvidersNisIdParams(editBody).Execute()
+	return err
+}
+
+// DeleteNISProvider deletes a NIS provider.
+func DeleteNISProvider(ctx context.Context, client *client.Client, name string) error {
+	_, err := client.PscaleOpenAPIClient.AuthApi.DeleteAuthv11ProvidersNisById(ctx, name).Execute()
+	return err
+}
+
+// getNISProvider reads the NIS provider with the given name.
+func getNISProvider(ctx context.Context, client *client.Client, name string) (*powerscale.V11ProvidersNisNisItem, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.GetAuthv11ProvidersNisById(ctx, name).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || len(result.Nis) == 0 {
+		return nil, fmt.Errorf("could not find NIS provider %s", name)
+	}
+	return &result.Nis[0], nil
+}
+
+// GetNISProviderState reads the NIS provider with the given name and maps it to the resource model.
+func GetNISProviderState(ctx context.Context, client *client.Client, name string, state *models.NISProviderResourceModel) error {
+	provider, err := getNISProvider(ctx, client, name)
+	if err != nil {
+		return err
+	}
+	if err := CopyFields(ctx, provider, state); err != nil {
+		return err
+	}
+	state.ID = types.StringValue(provider.GetName())
+	return nil
+}
+
+// ListNISProviders lists the NIS providers of the cluster.
+func ListNISProviders(ctx context.Context, client *client.Client) ([]powerscale.V11ProvidersNisNisItem, error) {
+	result, _, err := client.PscaleOpenAPIClient.AuthApi.ListAuthv11ProvidersNis(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return result.Nis, nil
+}
+
+// NewNISProviderDataSource creates a new NISProviderDataSourceModel from the NIS providers matching the filter.
+func NewNISProviderDataSource(ctx context.Context, providers []powerscale.V11ProvidersNisNisItem, filter *models.NISProviderFilterType) (*models.NISProviderDataSourceModel, error) {
+	var filtered []powerscale.V11ProvidersNisNisItem
+	if filter != nil && len(filter.Names) > 0 {
+		for _, name := range filter.Names {
+			found := false
+			for _, provider := range providers {
+				if provider.GetName() == name.ValueString() {
+					filtered = append(filtered, provider)
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("could not find NIS provider %s", name.ValueString())
+			}
+		}
+	} else {
+		filtered = providers
+	}
+
+	var err error
+	dsProviders := make([]models.NISProviderDetailModel, len(filtered))
+	for i := range filtered {
+		err = errors.Join(err, CopyFields(ctx, &filtered[i], &dsProviders[i]))
+		dsProviders[i].ID = types.StringValue(filtered[i].GetName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.NISProviderDataSourceModel{
+		ID:           types.StringValue("nis_provider_datasource"),
+		NISProviders: dsProviders,
+	}, nil
+}
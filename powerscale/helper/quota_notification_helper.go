@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateQuotaNotification creates a notification rule of a quota.
+func CreateQuotaNotification(ctx context.Context, client *client.Client, plan *models.QuotaNotificationResourceModel) (string, error) {
+	var createBody powerscale.V1QuotaQuotaNotification
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return "", err
+	}
+	result, _, err := client.PscaleOpenAPIClient.QuotaQuotasApi.CreateQuotaQuotasv1QuotaNotification(ctx, plan.QuotaID.ValueString()).V1QuotaQuotaNotification(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.Id, nil
+}
+
+// UpdateQuotaNotification updates a notification rule of a quota. The threshold and the condition cannot be updated.
+func UpdateQuotaNotification(ctx context.Context, client *client.Client, plan *models.QuotaNotificationResourceModel) error {
+	var editBody powerscale.V1QuotasQuotaNotification
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1QuotasQuotaNotification(ctx, plan.ID.ValueString(), plan.QuotaID.ValueString()).V1QuotasQuotaNotification(editBody).Execute()
+	return err
+}
+
+// DeleteQuotaNotification deletes a notification rule of a quota.
+func DeleteQuotaNotification(ctx context.Context, client *client.Client, quotaID, id string) error {
+	_, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1QuotasQuotaNotification(ctx, id, quotaID).Execute()
+	return err
+}
+
+// GetQuotaNotificationState reads a notification rule of a quota and maps it to the resource model.
+func GetQuotaNotificationState(ctx context.Context, client *client.Client, quotaID, id string, state *models.QuotaNotificationResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1QuotasQuotaNotification(ctx, id, quotaID).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Notifications) == 0 {
+		return fmt.Errorf("could not find notification rule %s of quota %s", id, quotaID)
+	}
+	if err := CopyFieldsToNonNestedModel(ctx, result.Notifications[0], state); err != nil {
+		return err
+	}
+	state.QuotaID = types.StringValue(quotaID)
+	return nil
+}
+
+// CreateQuotaDefaultNotification creates a global default notification rule.
+func CreateQuotaDefaultNotification(ctx context.Context, client *client.Client, plan *models.QuotaDefaultNotificationResourceModel) (string, error) {
+	var createBody powerscale.V1SettingsNotification
+	if err := ReadFromState(ctx, plan, &createBody); err != nil {
+		return "", err
+	}
+	result, _, err := client.PscaleOpenAPIClient.QuotaApi.CreateQuotav1SettingsNotification(ctx).V1SettingsNotification(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.Id, nil
+}
+
+// UpdateQuotaDefaultNotification updates a global default notification rule. The threshold and the condition cannot be updated.
+func UpdateQuotaDefaultNotification(ctx context.Context, client *client.Client, plan *models.QuotaDefaultNotificationResourceModel) error {
+	var editBody powerscale.V1SettingsNotificationExtendedExtended
+	if err := ReadFromState(ctx, plan, &editBody); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsNotification(ctx, plan.ID.ValueString()).V1SettingsNotification(editBody).Execute()
+	return err
+}
+
+// DeleteQuotaDefaultNotification deletes a global default notification rule.
+func DeleteQuotaDefaultNotification(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1SettingsNotification(ctx, id).Execute()
+	return err
+}
+
+// GetQuotaDefaultNotificationState reads a global default notification rule and maps it to the resource model.
+func GetQuotaDefaultNotificationState(ctx context.Context, client *client.Client, id string, state *models.QuotaDefaultNotificationResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1SettingsNotification(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Notifications) == 0 {
+		return fmt.Errorf("could not find default notification rule %s", id)
+	}
+	return CopyFieldsToNonNestedModel(ctx, result.Notifications[0], state)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateQuotaReport generates an on-demand quota report.
+func CreateQuotaReport(ctx context.Context, client *client.Client) (string, error) {
+	result, _, err := client.PscaleOpenAPIClient.QuotaApi.CreateQuotav1QuotaReport(ctx).V1QuotaReport(map[string]interface{}{}).Execute()
+	if err != nil {
+		return "", err
+	}
+	return result.Id, nil
+}
+
+// GetQuotaReport finds a quota report by ID. It returns nil if the report does not exist, ex. when it was rotated out.
+func GetQuotaReport(ctx context.Context, client *client.Client, id string) (*powerscale.V1QuotaReport, error) {
+	reports, err := ListQuotaReports(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range reports {
+		if reports[i].Id == id {
+			return &reports[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteQuotaReport deletes a quota report.
+func DeleteQuotaReport(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1QuotaReport(ctx, id).Execute()
+	return err
+}
+
+// ListQuotaReports list quota reports.
+func ListQuotaReports(ctx context.Context, client *client.Client, filter *models.QuotaReportFilterType) ([]powerscale.V1QuotaReport, error) {
+	listParam := client.PscaleOpenAPIClient.QuotaApi.ListQuotav1QuotaReports(ctx)
+	if filter != nil {
+		if !filter.Generated.IsNull() {
+			listParam = listParam.Generated(filter.Generated.ValueString())
+		}
+		if !filter.Type.IsNull() {
+			listParam = listParam.Type_(filter.Type.ValueString())
+		}
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	reports := resp.Reports
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.QuotaApi.ListQuotav1QuotaReports(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, resp.Reports...)
+	}
+	return reports, nil
+}
+
+// NewQuotaReportDataSource creates a new QuotaReportDataSourceModel from the quota reports.
+func NewQuotaReportDataSource(ctx context.Context, reports []powerscale.V1QuotaReport) (*models.QuotaReportDataSourceModel, error) {
+	var err error
+	dsReports := make([]models.QuotaReportModel, len(reports))
+	for i := range reports {
+		err = errors.Join(err, CopyFields(ctx, &reports[i], &dsReports[i]))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.QuotaReportDataSourceModel{
+		ID:           types.StringValue("quota_report_datasource"),
+		QuotaReports: dsReports,
+	}, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetQuotaReportSettings retrieve quota report settings.
+func GetQuotaReportSettings(ctx context.Context, client *client.Client) (*powerscale.V1SettingsReports, error) {
+	reportSettings, _, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1SettingsReports(ctx).Execute()
+	return reportSettings, err
+}
+
+// UpdateQuotaReportSettings update quota report settings.
+func UpdateQuotaReportSettings(ctx context.Context, client *client.Client, reportSettings powerscale.V1SettingsReportsExtended) error {
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsReports(ctx).V1SettingsReports(reportSettings).Execute()
+	return err
+}
+
+// GetQuotaMappingSettings retrieve quota mapping settings.
+func GetQuotaMappingSettings(ctx context.Context, client *client.Client) (*powerscale.V1SettingsMappings, error) {
+	mappingSettings, _, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1SettingsMappings(ctx).Execute()
+	return mappingSettings, err
+}
+
+// UpdateQuotaMappingSettings replaces the quota mappings with the planned ones.
+func UpdateQuotaMappingSettings(ctx context.Context, client *client.Client, plan models.QuotaMappingSettingsResourceModel) error {
+	mappings := make([]powerscale.V1SettingsMappingsMapping, 0, len(plan.Mappings))
+	for _, mapping := range plan.Mappings {
+		item := powerscale.V1SettingsMappingsMapping{
+			Domain:  mapping.Domain.ValueString(),
+			Mapping: mapping.Mapping.ValueString(),
+		}
+		if !mapping.Type.IsNull() && !mapping.Type.IsUnknown() {
+			item.Type = mapping.Type.ValueStringPointer()
+		}
+		mappings = append(mappings, item)
+	}
+	editBody := powerscale.V1SettingsMappingsExtended{Mappings: mappings}
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsMappings(ctx).V1SettingsMappings(editBody).Execute()
+	return err
+}
+
+// QuotaMappingSettingsDetailMapper Does the mapping from response to model.
+func QuotaMappingSettingsDetailMapper(mappingSettings *powerscale.V1SettingsMappings) models.QuotaMappingSettingsResourceModel {
+	state := models.QuotaMappingSettingsResourceModel{
+		Mappings: make([]models.QuotaMappingModel, 0, len(mappingSettings.Mappings)),
+	}
+	for _, mapping := range mappingSettings.Mappings {
+		state.Mappings = append(state.Mappings, models.QuotaMappingModel{
+			Domain:  types.StringValue(mapping.Domain),
+			Mapping: types.StringValue(mapping.Mapping),
+			Type:    types.StringValue(mapping.GetType()),
+		})
+	}
+	return state
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateSnapshotAlias creates a snapshot alias pointing to the target snapshot.
+func CreateSnapshotAlias(ctx context.Context, client *client.Client, plan *models.SnapshotAliasResourceModel) (string, error) {
+	createBody := powerscale.V1SnapshotAlias{
+		Name:   plan.Name.ValueString(),
+		Target: plan.Target.ValueStringPointer(),
+	}
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.CreateSnapshotv1SnapshotAlias(ctx).V1SnapshotAlias(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(result.Id), 10), nil
+}
+
+// UpdateSnapshotAlias renames and/or retargets a snapshot alias.
+func UpdateSnapshotAlias(ctx context.Context, client *client.Client, id string, plan *models.SnapshotAliasResourceModel, state *models.SnapshotAliasResourceModel) error {
+	editBody := powerscale.V1SnapshotAliasExtendedExtended{}
+	if !plan.Name.Equal(state.Name) {
+		editBody.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.Target.Equal(state.Target) {
+		editBody.Target = plan.Target.ValueStringPointer()
+	}
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.UpdateSnapshotv1SnapshotAlias(ctx, id).V1SnapshotAlias(editBody).Execute()
+	return err
+}
+
+// DeleteSnapshotAlias deletes a snapshot alias.
+func DeleteSnapshotAlias(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.DeleteSnapshotv1SnapshotAlias(ctx, id).Execute()
+	return err
+}
+
+// GetSnapshotAliasState reads a snapshot alias and maps it to the resource model.
+// The configured target is kept as long as the alias still points to the same snapshot,
+// so that both the name and the ID of the snapshot can be used as target.
+func GetSnapshotAliasState(ctx context.Context, client *client.Client, id string, state *models.SnapshotAliasResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv1SnapshotAlias(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Aliases) == 0 {
+		return fmt.Errorf("could not find snapshot alias with ID %s", id)
+	}
+	alias := result.Aliases[0]
+	targetID := strconv.FormatInt(int64(alias.GetTargetId()), 10)
+	if target := state.Target.ValueString(); target != alias.GetTargetName() && target != targetID {
+		state.Target = types.StringValue(alias.GetTargetName())
+	}
+	state.ID = types.StringValue(strconv.FormatInt(int64(alias.GetId()), 10))
+	state.Name = types.StringValue(alias.GetName())
+	state.TargetID = types.Int64Value(int64(alias.GetTargetId()))
+	state.TargetName = types.StringValue(alias.GetTargetName())
+	return nil
+}
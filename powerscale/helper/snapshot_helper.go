@@ -28,10 +28,43 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// GetAllSnapshots returns the full list of snapshots.
-func GetAllSnapshots(ctx context.Context, client *client.Client) ([]powerscale.V1SnapshotSnapshotExtended, error) {
-	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx).Execute()
-	return result.GetSnapshots(), err
+// GetAllSnapshots returns the full list of snapshots matching the server-side filters.
+// All pages are fetched by following the resume token, unless a limit is set.
+func GetAllSnapshots(ctx context.Context, client *client.Client, filter *models.SnapshotFilterType) ([]powerscale.V1SnapshotSnapshotExtended, error) {
+	listParam := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx)
+	if filter != nil {
+		if !filter.Schedule.IsNull() {
+			listParam = listParam.Schedule(filter.Schedule.ValueString())
+		}
+		if !filter.State.IsNull() {
+			listParam = listParam.State(filter.State.ValueString())
+		}
+		if !filter.Type.IsNull() {
+			listParam = listParam.Type_(filter.Type.ValueString())
+		}
+		if !filter.Sort.IsNull() {
+			listParam = listParam.Sort(filter.Sort.ValueString())
+		}
+		if !filter.Dir.IsNull() {
+			listParam = listParam.Dir(filter.Dir.ValueString())
+		}
+		if !filter.Limit.IsNull() {
+			listParam = listParam.Limit(int32(filter.Limit.ValueInt64()))
+		}
+	}
+	result, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	snapshots := result.GetSnapshots()
+	for result.Resume != nil && (filter == nil || filter.Limit.IsNull()) {
+		result, _, err = client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx).Resume(*result.Resume).Execute()
+		if err != nil {
+			return snapshots, err
+		}
+		snapshots = append(snapshots, result.GetSnapshots()...)
+	}
+	return snapshots, nil
 }
 
 // GetSpecificSnapshot returns a specific snapshot based on the id.
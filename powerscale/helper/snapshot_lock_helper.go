@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateSnapshotLock creates a lock on a snapshot.
+func CreateSnapshotLock(ctx context.Context, client *client.Client, plan *models.SnapshotLockResourceModel) (string, error) {
+	createBody := powerscale.V1SnapshotSnapshotLock{
+		Comment: plan.Comment.ValueStringPointer(),
+	}
+	if !plan.Expires.IsNull() && !plan.Expires.IsUnknown() {
+		expires := int32(plan.Expires.ValueInt64())
+		createBody.Expires = &expires
+	}
+	result, _, err := client.PscaleOpenAPIClient.SnapshotSnapshotsApi.CreateSnapshotSnapshotsv1SnapshotLock(ctx, plan.SnapshotID.ValueString()).V1SnapshotSnapshotLock(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(result.Id), 10), nil
+}
+
+// UpdateSnapshotLock updates the comment of a snapshot lock.
+func UpdateSnapshotLock(ctx context.Context, client *client.Client, plan *models.SnapshotLockResourceModel) error {
+	editBody := powerscale.V1SnapshotsSnapshotLock{
+		Comment: plan.Comment.ValueStringPointer(),
+	}
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.UpdateSnapshotv1SnapshotsSnapshotLock(ctx, plan.ID.ValueString(), plan.SnapshotID.ValueString()).V1SnapshotsSnapshotLock(editBody).Execute()
+	return err
+}
+
+// DeleteSnapshotLock deletes a snapshot lock.
+func DeleteSnapshotLock(ctx context.Context, client *client.Client, snapshotID, id string) error {
+	_, err := client.PscaleOpenAPIClient.SnapshotApi.DeleteSnapshotv1SnapshotsSnapshotLock(ctx, id, snapshotID).Execute()
+	return err
+}
+
+// GetSnapshotLockState reads a snapshot lock and maps it to the resource model.
+func GetSnapshotLockState(ctx context.Context, client *client.Client, snapshotID, id string, state *models.SnapshotLockResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv1SnapshotsSnapshotLock(ctx, id, snapshotID).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Locks) == 0 {
+		return fmt.Errorf("could not find lock %s of snapshot %s", id, snapshotID)
+	}
+	lock := result.Locks[0]
+	state.ID = types.StringValue(strconv.FormatInt(int64(lock.GetId()), 10))
+	state.SnapshotID = types.StringValue(snapshotID)
+	state.Comment = types.StringValue(lock.GetComment())
+	state.Expires = types.Int64Value(int64(lock.GetExpires()))
+	state.LockCount = types.Int64Value(int64(lock.GetCount()))
+	return nil
+}
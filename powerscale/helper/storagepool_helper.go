@@ -0,0 +1,255 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getTransferLimit returns the transfer limit of the plan, only sending the values which are known.
+func getTransferLimit(pct types.Int64, state types.String) (*int32, *string) {
+	var transferLimitPct *int32
+	if !pct.IsNull() && !pct.IsUnknown() {
+		limit := int32(pct.ValueInt64())
+		transferLimitPct = &limit
+	}
+	transferLimitState := GetKnownStringPointer(state)
+	if transferLimitState != nil && *transferLimitState == "" {
+		transferLimitState = nil
+	}
+	return transferLimitPct, transferLimitState
+}
+
+// int32ListValue converts a list of node numbers to a terraform list.
+func int32ListValue(values []int32) types.List {
+	elements := make([]int64, len(values))
+	for i, value := range values {
+		elements[i] = int64(value)
+	}
+	list, _ := types.ListValueFrom(context.Background(), types.Int64Type, elements)
+	return list
+}
+
+// CreateStoragepoolTier creates a storage pool tier.
+func CreateStoragepoolTier(ctx context.Context, client *client.Client, plan *models.StoragepoolTierResourceModel) (string, error) {
+	createBody := powerscale.V1StoragepoolTier{
+		Name: plan.Name.ValueString(),
+	}
+	if !plan.Children.IsNull() && !plan.Children.IsUnknown() {
+		if diags := plan.Children.ElementsAs(ctx, &createBody.Children, false); diags.HasError() {
+			return "", fmt.Errorf("could not read the children of the tier")
+		}
+	}
+	createBody.TransferLimitPct, createBody.TransferLimitState = getTransferLimit(plan.TransferLimitPct, plan.TransferLimitState)
+	result, _, err := client.PscaleOpenAPIClient.StoragepoolApi.CreateStoragepoolv1StoragepoolTier(ctx).V1StoragepoolTier(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(result.Id), 10), nil
+}
+
+// UpdateStoragepoolTier updates the name, the children and the transfer limit of a storage pool tier.
+func UpdateStoragepoolTier(ctx context.Context, client *client.Client, id string, plan *models.StoragepoolTierResourceModel, state *models.StoragepoolTierResourceModel) error {
+	editBody := powerscale.V1StoragepoolTierExtendedExtended{}
+	if !plan.Name.Equal(state.Name) {
+		editBody.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.Children.IsUnknown() && !plan.Children.Equal(state.Children) {
+		children := make([]string, 0)
+		if diags := plan.Children.ElementsAs(ctx, &children, false); diags.HasError() {
+			return fmt.Errorf("could not read the children of the tier")
+		}
+		editBody.Children = children
+	}
+	if !plan.TransferLimitPct.Equal(state.TransferLimitPct) || !plan.TransferLimitState.Equal(state.TransferLimitState) {
+		editBody.TransferLimitPct, editBody.TransferLimitState = getTransferLimit(plan.TransferLimitPct, plan.TransferLimitState)
+	}
+	_, err := client.PscaleOpenAPIClient.StoragepoolApi.UpdateStoragepoolv1StoragepoolTier(ctx, id).V1StoragepoolTier(editBody).Execute()
+	return err
+}
+
+// DeleteStoragepoolTier deletes a storage pool tier.
+func DeleteStoragepoolTier(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.StoragepoolApi.DeleteStoragepoolv1StoragepoolTier(ctx, id).Execute()
+	return err
+}
+
+// GetStoragepoolTierState reads a storage pool tier and maps it to the resource model.
+func GetStoragepoolTierState(ctx context.Context, client *client.Client, id string, state *models.StoragepoolTierResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv1StoragepoolTier(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Tiers) == 0 {
+		return fmt.Errorf("could not find storage pool tier with ID %s", id)
+	}
+	tier := result.Tiers[0]
+	children, diags := types.ListValueFrom(ctx, types.StringType, tier.Children)
+	if diags.HasError() {
+		return fmt.Errorf("could not map the children of the tier")
+	}
+	state.ID = types.StringValue(strconv.FormatInt(int64(tier.GetId()), 10))
+	state.Name = types.StringValue(tier.GetName())
+	state.Children = children
+	state.Lnns = int32ListValue(tier.Lnns)
+	state.TransferLimitPct = types.Int64Value(int64(tier.GetTransferLimitPct()))
+	state.TransferLimitState = types.StringValue(tier.GetTransferLimitState())
+	return nil
+}
+
+// CreateStoragepoolNodepool creates a manual node pool when nodes are planned,
+// otherwise it returns the ID of the existing node pool with the planned name.
+func CreateStoragepoolNodepool(ctx context.Context, client *client.Client, plan *models.StoragepoolNodepoolResourceModel) (string, error) {
+	if plan.Lnns.IsNull() || plan.Lnns.IsUnknown() {
+		result, _, err := client.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv3StoragepoolNodepool(ctx, plan.Name.ValueString()).Execute()
+		if err != nil {
+			return "", err
+		}
+		if result == nil || len(result.Nodepools) == 0 {
+			return "", fmt.Errorf("could not find node pool %s", plan.Name.ValueString())
+		}
+		return strconv.FormatInt(int64(result.Nodepools[0].GetId()), 10), nil
+	}
+
+	var lnns []int64
+	if diags := plan.Lnns.ElementsAs(ctx, &lnns, false); diags.HasError() {
+		return "", fmt.Errorf("could not read the nodes of the node pool")
+	}
+	createBody := powerscale.V3StoragepoolNodepool{
+		Name:             plan.Name.ValueString(),
+		ProtectionPolicy: GetKnownStringPointer(plan.ProtectionPolicy),
+	}
+	for _, lnn := range lnns {
+		createBody.Lnns = append(createBody.Lnns, int32(lnn))
+	}
+	if !plan.L3.IsNull() && !plan.L3.IsUnknown() {
+		createBody.L3 = plan.L3.ValueBoolPointer()
+	}
+	createBody.TransferLimitPct, createBody.TransferLimitState = getTransferLimit(plan.TransferLimitPct, plan.TransferLimitState)
+	result, _, err := client.PscaleOpenAPIClient.StoragepoolApi.CreateStoragepoolv3StoragepoolNodepool(ctx).V3StoragepoolNodepool(createBody).Execute()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(result.Id), 10), nil
+}
+
+// UpdateStoragepoolNodepool updates the name, the protection policy, L3 and the transfer limit of a node pool.
+func UpdateStoragepoolNodepool(ctx context.Context, client *client.Client, id string, plan *models.StoragepoolNodepoolResourceModel, state *models.StoragepoolNodepoolResourceModel) error {
+	editBody := powerscale.V3StoragepoolNodepoolExtendedExtended{}
+	if !plan.Name.Equal(state.Name) {
+		editBody.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.ProtectionPolicy.IsUnknown() && !plan.ProtectionPolicy.Equal(state.ProtectionPolicy) {
+		editBody.ProtectionPolicy = plan.ProtectionPolicy.ValueStringPointer()
+	}
+	if !plan.L3.IsUnknown() && !plan.L3.Equal(state.L3) {
+		editBody.L3 = plan.L3.ValueBoolPointer()
+	}
+	if !plan.TransferLimitPct.Equal(state.TransferLimitPct) || !plan.TransferLimitState.Equal(state.TransferLimitState) {
+		editBody.TransferLimitPct, editBody.TransferLimitState = getTransferLimit(plan.TransferLimitPct, plan.TransferLimitState)
+	}
+	_, err := client.PscaleOpenAPIClient.StoragepoolApi.UpdateStoragepoolv3StoragepoolNodepool(ctx, id).V3StoragepoolNodepool(editBody).Execute()
+	return err
+}
+
+// DeleteStoragepoolNodepool deletes a manual node pool. Automatically provisioned node pools cannot be deleted.
+func DeleteStoragepoolNodepool(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.StoragepoolApi.DeleteStoragepoolv3StoragepoolNodepool(ctx, id).Execute()
+	return err
+}
+
+// GetStoragepoolNodepoolState reads a node pool and maps it to the resource model.
+func GetStoragepoolNodepoolState(ctx context.Context, client *client.Client, id string, state *models.StoragepoolNodepoolResourceModel) error {
+	result, _, err := client.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv3StoragepoolNodepool(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if result == nil || len(result.Nodepools) == 0 {
+		return fmt.Errorf("could not find node pool with ID %s", id)
+	}
+	nodepool := result.Nodepools[0]
+	state.ID = types.StringValue(strconv.FormatInt(int64(nodepool.GetId()), 10))
+	state.Name = types.StringValue(nodepool.GetName())
+	state.Lnns = int32ListValue(nodepool.Lnns)
+	state.ProtectionPolicy = types.StringValue(nodepool.GetProtectionPolicy())
+	state.L3 = types.BoolValue(nodepool.GetL3())
+	state.TransferLimitPct = types.Int64Value(int64(nodepool.GetTransferLimitPct()))
+	state.TransferLimitState = types.StringValue(nodepool.GetTransferLimitState())
+	state.Manual = types.BoolValue(nodepool.GetManual())
+	state.Tier = types.StringNull()
+	if nodepool.HasTier() {
+		state.Tier = types.StringValue(strconv.FormatInt(int64(nodepool.GetTier()), 10))
+	}
+	return nil
+}
+
+// ListStoragepools lists the node pools and tiers of the cluster.
+func ListStoragepools(ctx context.Context, client *client.Client) ([]powerscale.V1StoragepoolStoragepool, error) {
+	result, _, err := client.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv1StoragepoolStoragepools(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return result.Storagepools, nil
+}
+
+// NewStoragepoolDataSource creates a new StoragepoolDataSourceModel from the storage pools matching the filter.
+func NewStoragepoolDataSource(ctx context.Context, storagepools []powerscale.V1StoragepoolStoragepool, filter *models.StoragepoolFilterType) (*models.StoragepoolDataSourceModel, error) {
+	var filtered []powerscale.V1StoragepoolStoragepool
+	if filter != nil && len(filter.Names) > 0 {
+		for _, name := range filter.Names {
+			found := false
+			for _, storagepool := range storagepools {
+				if storagepool.GetName() == name.ValueString() {
+					filtered = append(filtered, storagepool)
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("could not find storage pool %s", name.ValueString())
+			}
+		}
+	} else {
+		filtered = storagepools
+	}
+
+	var err error
+	dsStoragepools := make([]models.StoragepoolModel, 0, len(filtered))
+	for i := range filtered {
+		if filter != nil && !filter.Type.IsNull() && filtered[i].GetType() != filter.Type.ValueString() {
+			continue
+		}
+		var dsStoragepool models.StoragepoolModel
+		err = errors.Join(err, CopyFields(ctx, &filtered[i], &dsStoragepool))
+		dsStoragepools = append(dsStoragepools, dsStoragepool)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.StoragepoolDataSourceModel{
+		ID:           types.StringValue("storagepool_datasource"),
+		Storagepools: dsStoragepools,
+	}, nil
+}
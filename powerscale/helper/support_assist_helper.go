@@ -225,7 +225,17 @@ func ManageSupportAssist(ctx context.Context, client *client.Client, plan models
 
 		jobState := "COMPLETED"
 		for *response.Tasks.State != jobState {
-			time.Sleep(time.Second)
+			select {
+			case <-ctx.Done():
+				resp.AddError(
+					"Error while support assist provisioning",
+					fmt.Sprintf("Timed out waiting for support assist task %s to complete", taskCreate.TaskId),
+				)
+				// the operation timed out, read back the current details without the deadline
+				state, _ = ReadSupportAssistDetails(context.WithoutCancel(ctx), client, plan)
+				return state, resp
+			case <-time.After(time.Second):
+			}
 			if clusterVersion == "9.5.0.0" {
 				response, err = GetSupportAssistv16Task(ctx, client, taskCreate.TaskId)
 			} else {
@@ -238,6 +248,8 @@ func ManageSupportAssist(ctx context.Context, client *client.Client, plan models
 					"Error getting support assist task",
 					message,
 				)
+				state, _ = ReadSupportAssistDetails(ctx, client, plan)
+				return state, resp
 			}
 		}
 
@@ -261,6 +273,7 @@ func ManageSupportAssist(ctx context.Context, client *client.Client, plan models
 
 // ReadSupportAssistDetails reads the support assist details.
 func ReadSupportAssistDetails(ctx context.Context, client *client.Client, plan models.SupportAssistModel) (state models.SupportAssistModel, resp diag.Diagnostics) {
+	state.Timeouts = plan.Timeouts
 	supportAssist, err := GetSupportAssist(ctx, client)
 	if err != nil {
 		errStr := constants.ReadSupportAssistErrorMsg + "with error: "
@@ -0,0 +1,328 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SyncIQJobPollInterval is the interval between two polls of a running SyncIQ job.
+var SyncIQJobPollInterval = 10 * time.Second
+
+// syncIQJobTerminalStates are the job states after which a job will make no further progress.
+var syncIQJobTerminalStates = map[string]bool{
+	"finished":        true,
+	"failed":          true,
+	"canceled":        true,
+	"needs_attention": true,
+	"skipped":         true,
+	"unknown":         true,
+}
+
+// syncIQJobFailedStates are the terminal job states which indicate that the job did not succeed.
+var syncIQJobFailedStates = map[string]bool{
+	"failed":          true,
+	"canceled":        true,
+	"needs_attention": true,
+	"unknown":         true,
+}
+
+// syncIQJobReportLookback is the number of most recent reports of a policy searched for the report of a job.
+const syncIQJobReportLookback = 10
+
+// CreateSyncIQJob starts a SyncIQ job.
+func CreateSyncIQJob(ctx context.Context, client *client.Client, job powerscale.V14SyncJob) (string, error) {
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv14SyncJob(ctx).V14SyncJob(job).Execute()
+	if err != nil {
+		return "", err
+	}
+	return resp.Id, nil
+}
+
+// GetSyncIQJobState returns the current state and the numeric job ID of a SyncIQ job.
+// A job which is no longer listed by the cluster has completed, in which case found is false.
+func GetSyncIQJobState(ctx context.Context, client *client.Client, id string) (state string, jobID int64, found bool, err error) {
+	resp, httpResp, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv14SyncJob(ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return "", 0, false, nil
+		}
+		return "", 0, false, err
+	}
+	if resp == nil || len(resp.Jobs) == 0 {
+		return "", 0, false, nil
+	}
+	return resp.Jobs[0].GetState(), int64(resp.Jobs[0].GetJobId()), true, nil
+}
+
+// IsSyncIQTargetAction returns true if the SyncIQ job action runs on the target cluster of the policy.
+func IsSyncIQTargetAction(action string) bool {
+	return action == "allow_write" || action == "allow_write_revert"
+}
+
+// GetSyncIQPolicyName returns the name of the SyncIQ policy with the given ID or name.
+// When target is true, the policy is looked up among the policies which target this cluster.
+func GetSyncIQPolicyName(ctx context.Context, client *client.Client, policy string, target bool) (string, error) {
+	if target {
+		policies, err := ListSyncIQTargetPolicies(ctx, client, nil)
+		if err != nil {
+			return "", err
+		}
+		for _, targetPolicy := range policies {
+			if targetPolicy.GetId() == policy || targetPolicy.GetName() == policy {
+				return targetPolicy.GetName(), nil
+			}
+		}
+		return "", fmt.Errorf("could not find SyncIQ target policy %s", policy)
+	}
+	resp, err := GetSyncIQPolicyByID(ctx, client, policy)
+	if err != nil {
+		return "", err
+	}
+	if resp == nil || len(resp.Policies) == 0 {
+		return "", fmt.Errorf("could not find SyncIQ policy %s", policy)
+	}
+	return resp.Policies[0].Name, nil
+}
+
+// ListSyncIQJobReports returns the most recent reports of the policy with the given name, sorted by descending start time.
+// When target is true, the reports generated on this cluster as the target of the policy are returned.
+func ListSyncIQJobReports(ctx context.Context, client *client.Client, policyName string, target bool, limit int32) ([]models.SyncIQJobReportModel, error) {
+	if target {
+		resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv14TargetReports(ctx).PolicyName(policyName).Sort("start_time").Dir("DESC").Limit(limit).Execute()
+		if err != nil {
+			return nil, err
+		}
+		return newSyncIQJobReportModels(ctx, resp.Reports)
+	}
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv14SyncReports(ctx).PolicyName(policyName).Sort("start_time").Dir("DESC").Limit(limit).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return newSyncIQJobReportModels(ctx, resp.Reports)
+}
+
+// newSyncIQJobReportModels converts source or target reports to SyncIQ job report models.
+func newSyncIQJobReportModels[V SyncIQReportDataSourceResponse](ctx context.Context, reports []V) ([]models.SyncIQJobReportModel, error) {
+	var err error
+	jobReports := make([]models.SyncIQJobReportModel, len(reports))
+	for i := range reports {
+		var item models.SyncIQJobReportModel
+		ierr := CopyFields(ctx, &reports[i], &item)
+		err = errors.Join(err, ierr)
+		// reports without messages should still carry the element type
+		if item.Errors.IsNull() {
+			item.Errors = types.ListNull(types.StringType)
+		}
+		if item.Warnings.IsNull() {
+			item.Warnings = types.ListNull(types.StringType)
+		}
+		jobReports[i] = item
+	}
+	if err != nil {
+		return nil, err
+	}
+	return jobReports, nil
+}
+
+// GetLatestSyncIQReport returns the most recent report generated for the policy with the given name.
+func GetLatestSyncIQReport(ctx context.Context, client *client.Client, policyName string, target bool) (*models.SyncIQJobReportModel, error) {
+	reports, err := ListSyncIQJobReports(ctx, client, policyName, target, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	return &reports[0], nil
+}
+
+// GetSyncIQJobReport returns the report of a SyncIQ job of the given policy, or nil if it is not written yet.
+// The report is selected by the numeric job ID. When the job ID is unknown, because the job completed before it
+// could be observed, the first report of the policy following the report of the previous job is selected.
+func GetSyncIQJobReport(ctx context.Context, client *client.Client, policyName string, target bool, jobID, previousJobID int64) (*models.SyncIQJobReportModel, error) {
+	reports, err := ListSyncIQJobReports(ctx, client, policyName, target, syncIQJobReportLookback)
+	if err != nil {
+		return nil, err
+	}
+	var report *models.SyncIQJobReportModel
+	for i := range reports {
+		if jobID != 0 {
+			if reports[i].JobID.ValueInt64() == jobID {
+				return &reports[i], nil
+			}
+			continue
+		}
+		// the reports are sorted by descending start time, keep the earliest one following the previous job
+		if reports[i].JobID.ValueInt64() > previousJobID {
+			report = &reports[i]
+		}
+	}
+	return report, nil
+}
+
+// WaitForSyncIQJob polls the SyncIQ job until it reaches a terminal state or the context is done.
+// Once the job is no longer listed by the cluster, its final state is taken from its report.
+// It returns the final job state and the report of the job, if one was found.
+func WaitForSyncIQJob(ctx context.Context, client *client.Client, id, policyName string, target bool, previousJobID int64) (string, *models.SyncIQJobReportModel, error) {
+	lastState := ""
+	var jobID int64
+	for {
+		state, currentJobID, found, err := GetSyncIQJobState(ctx, client, id)
+		if err != nil {
+			return lastState, nil, err
+		}
+		if found {
+			lastState = state
+			jobID = currentJobID
+		}
+		if !found || syncIQJobTerminalStates[lastState] {
+			report, err := GetSyncIQJobReport(ctx, client, policyName, target, jobID, previousJobID)
+			if err != nil {
+				return lastState, nil, err
+			}
+			if report != nil {
+				return report.State.ValueString(), report, nil
+			}
+			if found {
+				// the job is listed in a terminal state, but its report is not written yet
+				return lastState, nil, nil
+			}
+			tflog.Debug(ctx, fmt.Sprintf("SyncIQ job %s has completed, waiting for its report", id))
+		} else {
+			tflog.Debug(ctx, fmt.Sprintf("SyncIQ job %s is in state %s, waiting", id, state))
+		}
+		select {
+		case <-ctx.Done():
+			return lastState, nil, fmt.Errorf("timed out waiting for SyncIQ job %s, last state: %s", id, lastState)
+		case <-time.After(SyncIQJobPollInterval):
+		}
+	}
+}
+
+// IsSyncIQJobStateFailed returns true if the job state indicates that the job did not succeed.
+func IsSyncIQJobStateFailed(state string) bool {
+	return syncIQJobFailedStates[state]
+}
+
+// SyncIQJobReportAttrTypes returns the attribute types of the SyncIQ job report object.
+func SyncIQJobReportAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"job_id":            types.Int64Type,
+		"policy_id":         types.StringType,
+		"policy_name":       types.StringType,
+		"action":            types.StringType,
+		"state":             types.StringType,
+		"start_time":        types.Int64Type,
+		"end_time":          types.Int64Type,
+		"duration":          types.Int64Type,
+		"total_files":       types.Int64Type,
+		"files_transferred": types.Int64Type,
+		"bytes_transferred": types.Int64Type,
+		"errors":            types.ListType{ElemType: types.StringType},
+		"warnings":          types.ListType{ElemType: types.StringType},
+	}
+}
+
+// NewSyncIQJobReportObject converts a SyncIQ job report to a terraform object.
+func NewSyncIQJobReportObject(ctx context.Context, report *models.SyncIQJobReportModel) (types.Object, diag.Diagnostics) {
+	if report == nil {
+		return types.ObjectNull(SyncIQJobReportAttrTypes()), nil
+	}
+	return types.ObjectValueFrom(ctx, SyncIQJobReportAttrTypes(), report)
+}
+
+// RunSyncIQJob starts a SyncIQ job from the plan, optionally waits for it to complete and populates the state.
+func RunSyncIQJob(ctx context.Context, client *client.Client, plan models.SyncIQJobResource) (models.SyncIQJobResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	state.Report = types.ObjectNull(SyncIQJobReportAttrTypes())
+
+	job := powerscale.V14SyncJob{
+		Id:             plan.PolicyID.ValueString(),
+		Action:         GetKnownStringPointer(plan.Action),
+		SourceSnapshot: GetKnownStringPointer(plan.SourceSnapshot),
+	}
+	if !plan.WorkersPerNode.IsNull() && !plan.WorkersPerNode.IsUnknown() {
+		workers := int32(plan.WorkersPerNode.ValueInt64())
+		job.WorkersPerNode = &workers
+	}
+
+	// allow_write and allow_write_revert run on the target cluster, where the policy is a target policy
+	target := IsSyncIQTargetAction(plan.Action.ValueString())
+	policyName, err := GetSyncIQPolicyName(ctx, client, plan.PolicyID.ValueString(), target)
+	if err != nil {
+		diags.AddError("Error starting SyncIQ job", GetErrorString(err, "Could not read SyncIQ policy with error: "))
+		return state, diags
+	}
+
+	// the job IDs of a policy increase with every run, the report of the new job follows the latest one
+	var previousJobID int64
+	latest, err := GetLatestSyncIQReport(ctx, client, policyName, target)
+	if err != nil {
+		diags.AddError("Error starting SyncIQ job", GetErrorString(err, "Could not read SyncIQ report with error: "))
+		return state, diags
+	}
+	if latest != nil {
+		previousJobID = latest.JobID.ValueInt64()
+	}
+
+	id, err := CreateSyncIQJob(ctx, client, job)
+	if err != nil {
+		diags.AddError("Error starting SyncIQ job", GetErrorString(err, "Could not start SyncIQ job with error: "))
+		return state, diags
+	}
+	if id == "" {
+		id = plan.PolicyID.ValueString()
+	}
+	state.ID = types.StringValue(id)
+	state.State = types.StringValue("running")
+
+	if !plan.WaitForCompletion.ValueBool() {
+		return state, diags
+	}
+
+	jobState, report, err := WaitForSyncIQJob(ctx, client, id, policyName, target, previousJobID)
+	if err != nil {
+		diags.AddError("Error waiting for SyncIQ job", GetErrorString(err, "Could not get SyncIQ job state with error: "))
+		return state, diags
+	}
+	state.State = types.StringValue(jobState)
+	reportObj, dgs := NewSyncIQJobReportObject(ctx, report)
+	diags.Append(dgs...)
+	state.Report = reportObj
+
+	if IsSyncIQJobStateFailed(jobState) {
+		diags.AddError("SyncIQ job did not succeed",
+			fmt.Sprintf("SyncIQ job %s for policy %s finished in state %s", id, policyName, jobState))
+	}
+	return state, diags
+}
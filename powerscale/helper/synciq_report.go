@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListSyncIQReports lists the SyncIQ job reports generated on this cluster as the source.
+func ListSyncIQReports(ctx context.Context, client *client.Client, filter *models.SyncIQReportFilterType) ([]powerscale.V14SyncReport, error) {
+	listParam := client.PscaleOpenAPIClient.SyncApi.ListSyncv14SyncReports(ctx)
+	if filter != nil {
+		if !filter.PolicyName.IsNull() {
+			listParam = listParam.PolicyName(filter.PolicyName.ValueString())
+		}
+		if !filter.State.IsNull() {
+			listParam = listParam.State(filter.State.ValueString())
+		}
+		if !filter.NewerThan.IsNull() {
+			listParam = listParam.NewerThan(int32(filter.NewerThan.ValueInt64()))
+		}
+		if !filter.Sort.IsNull() {
+			listParam = listParam.Sort(filter.Sort.ValueString())
+		}
+		if !filter.Dir.IsNull() {
+			listParam = listParam.Dir(filter.Dir.ValueString())
+		}
+		if !filter.Limit.IsNull() {
+			listParam = listParam.Limit(int32(filter.Limit.ValueInt64()))
+		}
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	reports := resp.Reports
+	for resp.Resume != nil && (filter == nil || filter.Limit.IsNull()) {
+		resp, _, err = client.PscaleOpenAPIClient.SyncApi.ListSyncv14SyncReports(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, resp.Reports...)
+	}
+	return reports, nil
+}
+
+// ListSyncIQTargetReports lists the SyncIQ job reports generated on this cluster as the target.
+func ListSyncIQTargetReports(ctx context.Context, client *client.Client, filter *models.SyncIQReportFilterType) ([]powerscale.V14TargetReport, error) {
+	listParam := client.PscaleOpenAPIClient.SyncApi.ListSyncv14TargetReports(ctx)
+	if filter != nil {
+		if !filter.PolicyName.IsNull() {
+			listParam = listParam.PolicyName(filter.PolicyName.ValueString())
+		}
+		if !filter.State.IsNull() {
+			listParam = listParam.State(filter.State.ValueString())
+		}
+		if !filter.NewerThan.IsNull() {
+			listParam = listParam.NewerThan(int32(filter.NewerThan.ValueInt64()))
+		}
+		if !filter.Sort.IsNull() {
+			listParam = listParam.Sort(filter.Sort.ValueString())
+		}
+		if !filter.Dir.IsNull() {
+			listParam = listParam.Dir(filter.Dir.ValueString())
+		}
+		if !filter.Limit.IsNull() {
+			listParam = listParam.Limit(int32(filter.Limit.ValueInt64()))
+		}
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	reports := resp.Reports
+	for resp.Resume != nil && (filter == nil || filter.Limit.IsNull()) {
+		resp, _, err = client.PscaleOpenAPIClient.SyncApi.ListSyncv14TargetReports(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, resp.Reports...)
+	}
+	return reports, nil
+}
+
+// SyncIQReportDataSourceResponse is the union of all response types for SyncIQ report datasources.
+type SyncIQReportDataSourceResponse interface {
+	powerscale.V14SyncReport | powerscale.V14TargetReport
+}
+
+// NewSyncIQReportDataSource creates a new SyncIQReportDataSource from source or target reports.
+func NewSyncIQReportDataSource[V SyncIQReportDataSourceResponse](ctx context.Context, id string, reports []V) (*models.SyncIQReportDataSource, error) {
+	var err error
+	dsReports := make([]models.SyncIQReportModel, len(reports))
+	for i := range reports {
+		var item models.SyncIQReportModel
+		ierr := CopyFields(ctx, &reports[i], &item)
+		err = errors.Join(err, ierr)
+		// reports without messages should still carry the element type
+		if item.Errors.IsNull() {
+			item.Errors = types.ListNull(types.StringType)
+		}
+		if item.Warnings.IsNull() {
+			item.Warnings = types.ListNull(types.StringType)
+		}
+		dsReports[i] = item
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.SyncIQReportDataSource{
+		ID:      types.StringValue(id),
+		Reports: dsReports,
+	}, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SyncIQReportDataSourceSchema defines the schema shared by the SyncIQ source and target report data sources.
+func SyncIQReportDataSourceSchema(ctx context.Context, description string) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"reports": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of SyncIQ job reports.",
+				MarkdownDescription: "List of SyncIQ job reports.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "A unique identifier for this object.",
+							MarkdownDescription: "A unique identifier for this object.",
+						},
+						"job_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the job.",
+							MarkdownDescription: "The ID of the job.",
+						},
+						"policy_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the policy.",
+							MarkdownDescription: "The ID of the policy.",
+						},
+						"policy_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the policy.",
+							MarkdownDescription: "The name of the policy.",
+						},
+						"action": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The action performed by the job.",
+							MarkdownDescription: "The action performed by the job.",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The state of the job.",
+							MarkdownDescription: "The state of the job.",
+						},
+						"sync_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of sync being performed by the job.",
+							MarkdownDescription: "The type of sync being performed by the job.",
+						},
+						"start_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The time the job started in unix epoch seconds.",
+							MarkdownDescription: "The time the job started in unix epoch seconds.",
+						},
+						"end_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The time the job ended in unix epoch seconds.",
+							MarkdownDescription: "The time the job ended in unix epoch seconds.",
+						},
+						"duration": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The amount of time in seconds between when the job was started and when it ended.",
+							MarkdownDescription: "The amount of time in seconds between when the job was started and when it ended.",
+						},
+						"total_files": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of files affected by the job.",
+							MarkdownDescription: "The number of files affected by the job.",
+						},
+						"files_transferred": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of files transferred by the job.",
+							MarkdownDescription: "The number of files transferred by the job.",
+						},
+						"bytes_transferred": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of bytes transferred by the job.",
+							MarkdownDescription: "The number of bytes transferred by the job.",
+						},
+						"total_network_bytes": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of bytes sent over the network by the job.",
+							MarkdownDescription: "The number of bytes sent over the network by the job.",
+						},
+						"errors": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "A list of error messages for the job.",
+							MarkdownDescription: "A list of error messages for the job.",
+						},
+						"warnings": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "A list of warning messages for the job.",
+							MarkdownDescription: "A list of warning messages for the job.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching SyncIQ job reports.",
+				MarkdownDescription: "Filters for fetching SyncIQ job reports.",
+				Attributes: map[string]schema.Attribute{
+					"policy_name": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list reports of the policy with this name.",
+						MarkdownDescription: "Only list reports of the policy with this name.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"state": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list reports of jobs in this state.",
+						MarkdownDescription: "Only list reports of jobs in this state.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"scheduled",
+								"running",
+								"paused",
+								"finished",
+								"failed",
+								"canceled",
+								"needs_attention",
+								"skipped",
+								"pending",
+								"unknown",
+							),
+						},
+					},
+					"newer_than": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Only list reports newer than this number of days.",
+						MarkdownDescription: "Only list reports newer than this number of days.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"sort": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The field that will be used for sorting.",
+						MarkdownDescription: "The field that will be used for sorting.",
+					},
+					"dir": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The direction of the sort.",
+						MarkdownDescription: "The direction of the sort.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("ASC", "DESC"),
+						},
+					},
+					"limit": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Return no more than this many results.",
+						MarkdownDescription: "Return no more than this many results.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, math.MaxInt32),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	powerscale "dell/powerscale-go-client"
 	"errors"
+	"fmt"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
 
@@ -78,3 +79,36 @@ func NewSyncIQRuleDataSource[V SyncIQRuleDataSourceResponse](ctx context.Context
 	}
 	return &ret, nil
 }
+
+// CreateSyncIQRule creates a SyncIQ performance rule.
+func CreateSyncIQRule(ctx context.Context, client *client.Client, rule powerscale.V3SyncRule) (string, error) {
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv3SyncRule(ctx).V3SyncRule(rule).Execute()
+	if err != nil {
+		return "", err
+	}
+	return resp.Id, nil
+}
+
+// UpdateSyncIQRule updates a SyncIQ performance rule.
+func UpdateSyncIQRule(ctx context.Context, client *client.Client, id string, rule powerscale.V3SyncRuleExtendedExtended) error {
+	_, err := client.PscaleOpenAPIClient.SyncApi.UpdateSyncv3SyncRule(ctx, id).V3SyncRule(rule).Execute()
+	return err
+}
+
+// DeleteSyncIQRule deletes a SyncIQ performance rule.
+func DeleteSyncIQRule(ctx context.Context, client *client.Client, id string) error {
+	_, err := client.PscaleOpenAPIClient.SyncApi.DeleteSyncv3SyncRule(ctx, id).Execute()
+	return err
+}
+
+// GetSyncIQRuleResourceState reads a SyncIQ performance rule and maps it to the resource model.
+func GetSyncIQRuleResourceState(ctx context.Context, client *client.Client, id string, state *models.SyncIQRuleResource) error {
+	resp, err := GetSyncIQRuleByID(ctx, client, id)
+	if err != nil {
+		return err
+	}
+	if len(resp.Rules) == 0 {
+		return fmt.Errorf("could not find SyncIQ rule with ID %s", id)
+	}
+	return CopyFieldsToNonNestedModel(ctx, resp.Rules[0], state)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"errors"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListSyncIQTargetPolicies lists the SyncIQ policies which target this cluster.
+func ListSyncIQTargetPolicies(ctx context.Context, client *client.Client, filter *models.SyncIQTargetPolicyFilterType) ([]powerscale.V14TargetPolicy, error) {
+	listParam := client.PscaleOpenAPIClient.SyncApi.ListSyncv14TargetPolicies(ctx)
+	if filter != nil && !filter.TargetPath.IsNull() {
+		listParam = listParam.TargetPath(filter.TargetPath.ValueString())
+	}
+	resp, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	policies := resp.Policies
+	for resp.Resume != nil {
+		resp, _, err = client.PscaleOpenAPIClient.SyncApi.ListSyncv14TargetPolicies(ctx).Resume(*resp.Resume).Execute()
+		if err != nil {
+			return policies, err
+		}
+		policies = append(policies, resp.Policies...)
+	}
+	return policies, nil
+}
+
+// FilterSyncIQTargetPolicies filters the SyncIQ target policies by name and state locally.
+func FilterSyncIQTargetPolicies(policies []powerscale.V14TargetPolicy, filter *models.SyncIQTargetPolicyFilterType) []powerscale.V14TargetPolicy {
+	if filter == nil {
+		return policies
+	}
+	names := make(map[string]bool)
+	for _, name := range filter.Names {
+		names[name.ValueString()] = true
+	}
+	return slices.DeleteFunc(policies, func(p powerscale.V14TargetPolicy) bool {
+		if len(names) > 0 && !names[p.Name] {
+			return true
+		}
+		if !filter.LastJobState.IsNull() && p.GetLastJobState() != filter.LastJobState.ValueString() {
+			return true
+		}
+		if !filter.FailoverFailbackState.IsNull() && p.GetFailoverFailbackState() != filter.FailoverFailbackState.ValueString() {
+			return true
+		}
+		return false
+	})
+}
+
+// NewSyncIQTargetPolicyDataSource creates a new SyncIQTargetPolicyDataSource from the target policies.
+func NewSyncIQTargetPolicyDataSource(ctx context.Context, policies []powerscale.V14TargetPolicy) (*models.SyncIQTargetPolicyDataSource, error) {
+	var err error
+	dsPolicies := make([]models.SyncIQTargetPolicyModel, len(policies))
+	for i := range policies {
+		var item models.SyncIQTargetPolicyModel
+		ierr := CopyFields(ctx, &policies[i], &item)
+		err = errors.Join(err, ierr)
+		dsPolicies[i] = item
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.SyncIQTargetPolicyDataSource{
+		ID:       types.StringValue("synciq_target_policy_datasource"),
+		Policies: dsPolicies,
+	}, nil
+}
@@ -22,3 +22,19 @@ func GetKnownStringPointer(in types.String) *string {
 	}
 	return in.ValueStringPointer()
 }
+
+// GetKnownBoolPointer returns a pointer to the bool value if it is known, otherwise nil.
+func GetKnownBoolPointer(in types.Bool) *bool {
+	if in.IsUnknown() {
+		return nil
+	}
+	return in.ValueBoolPointer()
+}
+
+// GetKnownInt64Pointer returns a pointer to the int64 value if it is known, otherwise nil.
+func GetKnownInt64Pointer(in types.Int64) *int64 {
+	if in.IsUnknown() {
+		return nil
+	}
+	return in.ValueInt64Pointer()
+}
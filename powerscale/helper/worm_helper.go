@@ -0,0 +1,319 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// wormRetentionUnits lists the units of a WORM retention period, from the largest to the smallest,
+// with their suffix and their approximate length in seconds.
+var wormRetentionUnits = []struct {
+	field   string
+	suffix  string
+	seconds int64
+}{
+	{"years", "Y", 365 * 24 * 3600},
+	{"months", "M", 30 * 24 * 3600},
+	{"weeks", "W", 7 * 24 * 3600},
+	{"days", "D", 24 * 3600},
+	{"hours", "H", 3600},
+	{"minutes", "m", 60},
+	{"seconds", "s", 1},
+}
+
+// WormRetentionKeywords are the retentions which are not a period.
+var WormRetentionKeywords = []string{"forever", "use_min", "use_max"}
+
+// WormRetentionRegex matches a minimum or maximum WORM retention, either forever or a period such as 1Y6M.
+var WormRetentionRegex = regexp.MustCompile(`^(forever|(\d+[YMWDHms])+)$`)
+
+// WormDefaultRetentionRegex matches a default WORM retention, which can also be use_min or use_max.
+var WormDefaultRetentionRegex = regexp.MustCompile(`^(forever|use_min|use_max|(\d+[YMWDHms])+)$`)
+
+var wormRetentionPartRegex = regexp.MustCompile(`(\d+)([YMWDHms])`)
+
+// ParseWormRetention converts a retention such as forever or 1Y6M to its API representation.
+func ParseWormRetention(retention string) (interface{}, error) {
+	if !WormDefaultRetentionRegex.MatchString(retention) {
+		return nil, fmt.Errorf("invalid retention %q, expected one of %s or a period such as 1Y6M", retention, strings.Join(WormRetentionKeywords, ", "))
+	}
+	for _, keyword := range WormRetentionKeywords {
+		if retention == keyword {
+			return retention, nil
+		}
+	}
+	period := map[string]interface{}{}
+	for _, part := range wormRetentionPartRegex.FindAllStringSubmatch(retention, -1) {
+		value, err := strconv.ParseInt(part[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retention %q: %s", retention, err.Error())
+		}
+		for _, unit := range wormRetentionUnits {
+			if unit.suffix != part[2] {
+				continue
+			}
+			// a repeated unit would be read back merged, such as 1D1D as 2D, and never match the configuration
+			if _, ok := period[unit.field]; ok {
+				return nil, fmt.Errorf("invalid retention %q, the unit %s is repeated", retention, unit.suffix)
+			}
+			period[unit.field] = value
+		}
+	}
+	return period, nil
+}
+
+// FormatWormRetention converts a retention returned by the API to a string such as forever or 1Y6M.
+func FormatWormRetention(retention interface{}) string {
+	switch value := retention.(type) {
+	case string:
+		return value
+	case map[string]interface{}:
+		formatted := ""
+		for _, unit := range wormRetentionUnits {
+			var count int64
+			switch number := value[unit.field].(type) {
+			case float64:
+				count = int64(number)
+			case int64:
+				count = number
+			}
+			if count > 0 {
+				formatted += strconv.FormatInt(count, 10) + unit.suffix
+			}
+		}
+		if formatted == "" {
+			return "0s"
+		}
+		return formatted
+	default:
+		return ""
+	}
+}
+
+// WormRetentionSeconds returns the approximate length of a retention period in seconds.
+// A retention which is not a period is reported as not comparable.
+func WormRetentionSeconds(retention string) (int64, bool) {
+	period, err := ParseWormRetention(retention)
+	if err != nil {
+		return 0, false
+	}
+	fields, ok := period.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	var seconds int64
+	for _, unit := range wormRetentionUnits {
+		if count, ok := fields[unit.field].(int64); ok {
+			seconds += count * unit.seconds
+		}
+	}
+	return seconds, true
+}
+
+// wormDomainJSON is the JSON representation of a WORM domain, whose retentions may be a keyword or a period.
+type wormDomainJSON struct {
+	ID               *int64      `json:"id,omitempty"`
+	Path             *string     `json:"path,omitempty"`
+	Type             *string     `json:"type,omitempty"`
+	AutocommitOffset *int64      `json:"autocommit_offset,omitempty"`
+	DefaultRetention interface{} `json:"default_retention,omitempty"`
+	MinRetention     interface{} `json:"min_retention,omitempty"`
+	MaxRetention     interface{} `json:"max_retention,omitempty"`
+	OverrideDate     *int64      `json:"override_date,omitempty"`
+	PrivilegedDelete *string     `json:"privileged_delete,omitempty"`
+	Lin              *int64      `json:"lin,omitempty"`
+}
+
+// convertJSON converts a value to another type sharing the same JSON representation.
+func convertJSON(source interface{}, target interface{}) error {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// buildWormDomainJSON builds the WORM domain parameters which are set in the plan and differ from the state.
+// The path and the type are only set on creation.
+func buildWormDomainJSON(plan *models.WormDomainResourceModel, state *models.WormDomainResourceModel) (*wormDomainJSON, error) {
+	domain := &wormDomainJSON{}
+	if state == nil {
+		domain.Path = plan.Path.ValueStringPointer()
+		if !plan.Type.IsNull() && !plan.Type.IsUnknown() {
+			domain.Type = plan.Type.ValueStringPointer()
+		}
+		state = &models.WormDomainResourceModel{}
+	}
+	if !plan.AutocommitOffset.IsNull() && !plan.AutocommitOffset.IsUnknown() && !plan.AutocommitOffset.Equal(state.AutocommitOffset) {
+		domain.AutocommitOffset = plan.AutocommitOffset.ValueInt64Pointer()
+	}
+	if !plan.OverrideDate.IsNull() && !plan.OverrideDate.IsUnknown() && !plan.OverrideDate.Equal(state.OverrideDate) {
+		domain.OverrideDate = plan.OverrideDate.ValueInt64Pointer()
+	}
+	if !plan.PrivilegedDelete.IsNull() && !plan.PrivilegedDelete.IsUnknown() && !plan.PrivilegedDelete.Equal(state.PrivilegedDelete) {
+		domain.PrivilegedDelete = plan.PrivilegedDelete.ValueStringPointer()
+	}
+	retentions := []struct {
+		plan   types.String
+		state  types.String
+		target *interface{}
+	}{
+		{plan.DefaultRetention, state.DefaultRetention, &domain.DefaultRetention},
+		{plan.MinRetention, state.MinRetention, &domain.MinRetention},
+		{plan.MaxRetention, state.MaxRetention, &domain.MaxRetention},
+	}
+	for _, retention := range retentions {
+		if retention.plan.IsNull() || retention.plan.IsUnknown() || retention.plan.Equal(retention.state) {
+			continue
+		}
+		value, err := ParseWormRetention(retention.plan.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		*retention.target = value
+	}
+	return domain, nil
+}
+
+// CreateWormDomain creates a WORM domain and returns its ID.
+func CreateWormDomain(ctx context.Context, client *client.Client, plan *models.WormDomainResourceModel) (string, error) {
+	params, err := buildWormDomainJSON(plan, nil)
+	if err != nil {
+		return "", err
+	}
+	var domain powerscale.V1WormDomain
+	if err := convertJSON(params, &domain); err != nil {
+		return "", err
+	}
+	resp, _, err := client.PscaleOpenAPIClient.WormApi.CreateWormv1WormDomain(ctx).V1WormDomain(domain).Execute()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(resp.Id), 10), nil
+}
+
+// UpdateWormDomain updates the WORM domain parameters which differ from the state.
+func UpdateWormDomain(ctx context.Context, client *client.Client, id string, plan *models.WormDomainResourceModel, state *models.WormDomainResourceModel) error {
+	params, err := buildWormDomainJSON(plan, state)
+	if err != nil {
+		return err
+	}
+	if params.AutocommitOffset == nil && params.OverrideDate == nil && params.PrivilegedDelete == nil &&
+		params.DefaultRetention == nil && params.MinRetention == nil && params.MaxRetention == nil {
+		return nil
+	}
+	var domain powerscale.V1WormDomainExtendedExtended
+	if err := convertJSON(params, &domain); err != nil {
+		return err
+	}
+	_, err = client.PscaleOpenAPIClient.WormApi.UpdateWormv1WormDomain(ctx, id).V1WormDomain(domain).Execute()
+	return err
+}
+
+// GetWormDomainState reads a WORM domain and maps it to the resource model.
+func GetWormDomainState(ctx context.Context, client *client.Client, id string, state *models.WormDomainResourceModel) error {
+	resp, _, err := client.PscaleOpenAPIClient.WormApi.GetWormv1WormDomain(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	if len(resp.Domains) == 0 {
+		return fmt.Errorf("could not find WORM domain %s", id)
+	}
+	var domain wormDomainJSON
+	if err := convertJSON(resp.Domains[0], &domain); err != nil {
+		return err
+	}
+	state.ID = types.StringValue(id)
+	state.Path = types.StringPointerValue(domain.Path)
+	state.Type = types.StringPointerValue(domain.Type)
+	state.AutocommitOffset = types.Int64PointerValue(domain.AutocommitOffset)
+	state.DefaultRetention = wormRetentionValue(domain.DefaultRetention)
+	state.MinRetention = wormRetentionValue(domain.MinRetention)
+	state.MaxRetention = wormRetentionValue(domain.MaxRetention)
+	state.OverrideDate = types.Int64PointerValue(domain.OverrideDate)
+	state.PrivilegedDelete = types.StringPointerValue(domain.PrivilegedDelete)
+	state.Lin = types.Int64PointerValue(domain.Lin)
+	return nil
+}
+
+func wormRetentionValue(retention interface{}) types.String {
+	if formatted := FormatWormRetention(retention); formatted != "" {
+		return types.StringValue(formatted)
+	}
+	return types.StringNull()
+}
+
+// GetWormSettingsState reads the WORM settings and maps them to the resource model.
+func GetWormSettingsState(ctx context.Context, client *client.Client, state *models.WormSettingsResourceModel) error {
+	resp, _, err := client.PscaleOpenAPIClient.WormApi.GetWormv1WormSettings(ctx).Execute()
+	if err != nil {
+		return err
+	}
+	cdate := resp.Settings.GetCdate()
+	state.ID = types.StringValue("worm_settings")
+	state.SetComplianceClock = types.BoolValue(cdate > 0)
+	state.ComplianceClockTime = types.Int64Null()
+	if cdate > 0 {
+		state.ComplianceClockTime = types.Int64Value(int64(cdate))
+	}
+	return nil
+}
+
+// WormSettingsPath is the path of the WORM settings in the cluster API.
+const WormSettingsPath = "platform/1/worm/settings"
+
+// WormComplianceClockRequest returns the body of the WORM settings request which sets the compliance clock.
+// The PAPI reference of PUT /platform/1/worm/settings sets the compliance clock to the current system time
+// when cdate is an empty JSON object, which the generated client cannot send as cdate is typed as an integer.
+func WormComplianceClockRequest() map[string]interface{} {
+	return map[string]interface{}{"cdate": map[string]interface{}{}}
+}
+
+// SetWormComplianceClock sets the compliance clock to the current time of the cluster. It can only be done once.
+func SetWormComplianceClock(ctx context.Context, client *client.Client) error {
+	return client.SendJSONRequest(ctx, http.MethodPut, WormSettingsPath, WormComplianceClockRequest())
+}
+
+// UpdateWormSettings sets the compliance clock when it is planned and not set yet, and reads the WORM settings.
+func UpdateWormSettings(ctx context.Context, client *client.Client, plan *models.WormSettingsResourceModel, state *models.WormSettingsResourceModel) error {
+	if err := GetWormSettingsState(ctx, client, state); err != nil {
+		return err
+	}
+	if state.SetComplianceClock.ValueBool() && !plan.SetComplianceClock.IsNull() && !plan.SetComplianceClock.IsUnknown() && !plan.SetComplianceClock.ValueBool() {
+		return fmt.Errorf("the compliance clock of the cluster is already set and cannot be unset, set set_compliance_clock to true")
+	}
+	if !plan.SetComplianceClock.ValueBool() || state.SetComplianceClock.ValueBool() {
+		return nil
+	}
+	if err := SetWormComplianceClock(ctx, client); err != nil {
+		return err
+	}
+	return GetWormSettingsState(ctx, client, state)
+}
@@ -258,4 +258,54 @@ type AdsProviderResourceModel struct {
 	RecommendedSpns types.List `tfsdk:"recommended_spns"`
 	// Currently configured SPNs.
 	Spns types.List `tfsdk:"spns"`
+	// Specifies how the SPNs are reconciled on every apply, check or fix.
+	SpnsReconcileMode types.String `tfsdk:"spns_reconcile_mode"`
+	// Recommended and extra expected SPNs which are not configured.
+	MissingSpns types.List `tfsdk:"missing_spns"`
+}
+
+// AdsProviderDomainControllerDataSourceModel describes the domain controller data source data model.
+type AdsProviderDomainControllerDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// The ID of the ADS provider.
+	AdsProviderID     types.String                       `tfsdk:"ads_provider_id"`
+	DomainControllers []AdsProviderDomainControllerModel `tfsdk:"domain_controllers"`
+}
+
+// AdsProviderDomainControllerModel Specifies a domain controller of an ADS provider.
+type AdsProviderDomainControllerModel struct {
+	// Specifies the address of the domain controller.
+	Address types.String `tfsdk:"address"`
+	// Specifies the name of the domain controller.
+	Name types.String `tfsdk:"name"`
+}
+
+// AdsProviderTrustedDomainDataSourceModel describes the trusted domain data source data model.
+type AdsProviderTrustedDomainDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// The ID of the ADS provider.
+	AdsProviderID  types.String                    `tfsdk:"ads_provider_id"`
+	TrustedDomains []AdsProviderTrustedDomainModel `tfsdk:"trusted_domains"`
+}
+
+// AdsProviderTrustedDomainModel Specifies a trusted domain of an ADS provider.
+type AdsProviderTrustedDomainModel struct {
+	// Specifies the site where the Active Directory client is located.
+	ClientSiteName types.String `tfsdk:"client_site_name"`
+	// Specifies the address of the domain controller.
+	DcAddress types.String `tfsdk:"dc_address"`
+	// Specifies the name of the domain controller.
+	DcName types.String `tfsdk:"dc_name"`
+	// Specifies the site where the domain controller is located.
+	DcSiteName types.String `tfsdk:"dc_site_name"`
+	// Specifies the DNS name of the domain.
+	DNSName types.String `tfsdk:"dns_name"`
+	// Specifies the name of the Active Directory forest of the domain.
+	ForestName types.String `tfsdk:"forest_name"`
+	// Specifies the NetBIOS name of the domain.
+	NetbiosName types.String `tfsdk:"netbios_name"`
+	// Specifies the security identifier of the domain.
+	Sid types.String `tfsdk:"sid"`
+	// Specifies the status of the domain.
+	Status types.String `tfsdk:"status"`
 }
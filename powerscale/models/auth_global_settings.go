@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AuthGlobalSettingsDataSourceModel describes the datasource data model.
+type AuthGlobalSettingsDataSourceModel struct {
+	// Specifies the number of times to retry an ID allocation before failing.
+	AllocRetries types.Int64 `tfsdk:"alloc_retries"`
+	// Specifies the length of time in seconds to cache credential responses from the ID mapper.
+	CacheCredLifetime types.Int64 `tfsdk:"cache_cred_lifetime"`
+	// Specifies the length of time in seconds to cache ID responses from the ID mapper.
+	CacheIDLifetime types.Int64 `tfsdk:"cache_id_lifetime"`
+	// Specifies the time in seconds to delay a failed login.
+	FailedLoginDelayTime types.Int64 `tfsdk:"failed_login_delay_time"`
+	// Specifies the type of identity that is stored on disk.
+	OnDiskIdentity types.String `tfsdk:"on_disk_identity"`
+	// Specifies the minimum amount of time in milliseconds to wait before performing an oprestart.
+	RPCBlockTime types.Int64 `tfsdk:"rpc_block_time"`
+	// Specifies the maximum number of outstanding RPC requests.
+	RPCMaxRequests types.Int64 `tfsdk:"rpc_max_requests"`
+	// Specifies the maximum amount of time in seconds to wait for an idmap response.
+	RPCTimeout types.Int64 `tfsdk:"rpc_timeout"`
+	// Specifies whether to send NTLMv2 responses.
+	SendNtlmv2 types.Bool `tfsdk:"send_ntlmv2"`
+	// Specifies the space replacement character.
+	SpaceReplacement types.String `tfsdk:"space_replacement"`
+	// Specifies the GID to use for the unknown (anonymous) group.
+	UnknownGID types.Int64 `tfsdk:"unknown_gid"`
+	// Specifies the UID to use for the unknown (anonymous) user.
+	UnknownUID types.Int64 `tfsdk:"unknown_uid"`
+	// Specifies the NetBIOS workgroup or domain.
+	Workgroup types.String `tfsdk:"workgroup"`
+	// Specifies the access zone in which the global settings are applied.
+	Zone types.String `tfsdk:"zone"`
+}
+
+// AuthGlobalSettingsResourceModel describes the resource data model.
+type AuthGlobalSettingsResourceModel struct {
+	// Specifies the number of times to retry an ID allocation before failing.
+	AllocRetries types.Int64 `tfsdk:"alloc_retries"`
+	// Specifies the length of time in seconds to cache credential responses from the ID mapper.
+	CacheCredLifetime types.Int64 `tfsdk:"cache_cred_lifetime"`
+	// Specifies the length of time in seconds to cache ID responses from the ID mapper.
+	CacheIDLifetime types.Int64 `tfsdk:"cache_id_lifetime"`
+	// Specifies the time in seconds to delay a failed login.
+	FailedLoginDelayTime types.Int64 `tfsdk:"failed_login_delay_time"`
+	// Specifies the type of identity that is stored on disk.
+	OnDiskIdentity types.String `tfsdk:"on_disk_identity"`
+	// Specifies the minimum amount of time in milliseconds to wait before performing an oprestart.
+	RPCBlockTime types.Int64 `tfsdk:"rpc_block_time"`
+	// Specifies the maximum number of outstanding RPC requests.
+	RPCMaxRequests types.Int64 `tfsdk:"rpc_max_requests"`
+	// Specifies the maximum amount of time in seconds to wait for an idmap response.
+	RPCTimeout types.Int64 `tfsdk:"rpc_timeout"`
+	// Specifies whether to send NTLMv2 responses.
+	SendNtlmv2 types.Bool `tfsdk:"send_ntlmv2"`
+	// Specifies the space replacement character.
+	SpaceReplacement types.String `tfsdk:"space_replacement"`
+	// Specifies the GID to use for the unknown (anonymous) group.
+	UnknownGID types.Int64 `tfsdk:"unknown_gid"`
+	// Specifies the UID to use for the unknown (anonymous) user.
+	UnknownUID types.Int64 `tfsdk:"unknown_uid"`
+	// Specifies the NetBIOS workgroup or domain.
+	Workgroup types.String `tfsdk:"workgroup"`
+	// Specifies the access zone in which the global settings are applied.
+	Zone types.String `tfsdk:"zone"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AuthMappingSettingsDataSourceModel describes the datasource data model.
+type AuthMappingSettingsDataSourceModel struct {
+	// If true, allocate GIDs from the specified range.
+	GIDRangeEnabled types.Bool `tfsdk:"gid_range_enabled"`
+	// Specifies the ending number for allocating GIDs.
+	GIDRangeMax types.Int64 `tfsdk:"gid_range_max"`
+	// Specifies the starting number for allocating GIDs.
+	GIDRangeMin types.Int64 `tfsdk:"gid_range_min"`
+	// Specifies the next GID to be allocated.
+	GIDRangeNextGID types.Int64 `tfsdk:"gid_range_next_gid"`
+	// If true, allocate UIDs from the specified range.
+	UIDRangeEnabled types.Bool `tfsdk:"uid_range_enabled"`
+	// Specifies the ending number for allocating UIDs.
+	UIDRangeMax types.Int64 `tfsdk:"uid_range_max"`
+	// Specifies the starting number for allocating UIDs.
+	UIDRangeMin types.Int64 `tfsdk:"uid_range_min"`
+	// Specifies the next UID to be allocated.
+	UIDRangeNextUID types.Int64 `tfsdk:"uid_range_next_uid"`
+	// Specifies the access zone in which the mapping settings are applied.
+	Zone types.String `tfsdk:"zone"`
+}
+
+// AuthMappingSettingsResourceModel describes the resource data model.
+type AuthMappingSettingsResourceModel struct {
+	// If true, allocate GIDs from the specified range.
+	GIDRangeEnabled types.Bool `tfsdk:"gid_range_enabled"`
+	// Specifies the ending number for allocating GIDs.
+	GIDRangeMax types.Int64 `tfsdk:"gid_range_max"`
+	// Specifies the starting number for allocating GIDs.
+	GIDRangeMin types.Int64 `tfsdk:"gid_range_min"`
+	// Specifies the next GID to be allocated.
+	GIDRangeNextGID types.Int64 `tfsdk:"gid_range_next_gid"`
+	// If true, allocate UIDs from the specified range.
+	UIDRangeEnabled types.Bool `tfsdk:"uid_range_enabled"`
+	// Specifies the ending number for allocating UIDs.
+	UIDRangeMax types.Int64 `tfsdk:"uid_range_max"`
+	// Specifies the starting number for allocating UIDs.
+	UIDRangeMin types.Int64 `tfsdk:"uid_range_min"`
+	// Specifies the next UID to be allocated.
+	UIDRangeNextUID types.Int64 `tfsdk:"uid_range_next_uid"`
+	// Specifies the access zone in which the mapping settings are applied.
+	Zone types.String `tfsdk:"zone"`
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// CloudPoolsAccountResourceModel describes the CloudPools account resource data model.
+type CloudPoolsAccountResourceModel struct {
+	// The system ID given to the account.
+	ID types.String `tfsdk:"id"`
+	// The account name.
+	Name types.String `tfsdk:"name"`
+	// The type of cloud protocol required.
+	Type types.String `tfsdk:"type"`
+	// The URI of the cloud storage endpoint.
+	URI types.String `tfsdk:"uri"`
+	// The username of the cloud account.
+	AccountUsername types.String `tfsdk:"account_username"`
+	// The secret key of the cloud account.
+	Key types.String `tfsdk:"key"`
+	// The ID of the cloud account, required by some cloud types.
+	AccountID types.String `tfsdk:"account_id"`
+	// Whether the account is enabled.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// Whether the SSL certificate of the cloud storage endpoint is validated.
+	SkipSSLValidation types.Bool `tfsdk:"skip_ssl_validation"`
+	// Whether the connectivity check of the account is skipped.
+	SkipAccountCheck types.Bool `tfsdk:"skip_account_check"`
+	// The region of the cloud storage.
+	StorageRegion types.String `tfsdk:"storage_region"`
+	// The bucket used for telemetry reports.
+	TelemetryBucket types.String `tfsdk:"telemetry_bucket"`
+	// The name of the network proxy used to connect to the cloud storage.
+	Proxy types.String `tfsdk:"proxy"`
+	// The state of the account.
+	State types.String `tfsdk:"state"`
+	// The guid of the cluster on which the account was created.
+	BirthClusterID types.String `tfsdk:"birth_cluster_id"`
+}
+
+// CloudPoolsPoolResourceModel describes the CloudPools pool resource data model.
+type CloudPoolsPoolResourceModel struct {
+	// The system ID given to the pool.
+	ID types.String `tfsdk:"id"`
+	// The pool name.
+	Name types.String `tfsdk:"name"`
+	// The type of cloud protocol required.
+	Type types.String `tfsdk:"type"`
+	// The names of the accounts in the pool.
+	Accounts types.List `tfsdk:"accounts"`
+	// The description of the pool.
+	Description types.String `tfsdk:"description"`
+	// The vendor of the cloud storage.
+	Vendor types.String `tfsdk:"vendor"`
+	// The state of the pool.
+	State types.String `tfsdk:"state"`
+	// The guid of the cluster on which the pool was created.
+	BirthClusterID types.String `tfsdk:"birth_cluster_id"`
+}
+
+// CloudPoolsSettingsResourceModel describes the CloudPools settings resource data model.
+type CloudPoolsSettingsResourceModel struct {
+	// The ID of the settings, always cloudpools_settings.
+	ID types.String `tfsdk:"id"`
+	// Whether files with snapshots are archived by default.
+	ArchiveSnapshotFiles types.Bool `tfsdk:"archive_snapshot_files"`
+	// The default cache expiration.
+	CacheExpiration types.Int64 `tfsdk:"cache_expiration"`
+	// The default cache read ahead type.
+	CacheReadAhead types.String `tfsdk:"cache_read_ahead"`
+	// The default cache type.
+	CacheType types.String `tfsdk:"cache_type"`
+	// Whether files are compressed by default.
+	Compression types.Bool `tfsdk:"compression"`
+	// The default minimum amount of time archived data will be retained in the cloud after deletion.
+	DataRetention types.Int64 `tfsdk:"data_retention"`
+	// Whether files are encrypted by default.
+	Encryption types.Bool `tfsdk:"encryption"`
+	// The default minimum amount of time cloud files will be retained after the creation of a full NDMP backup.
+	FullBackupRetention types.Int64 `tfsdk:"full_backup_retention"`
+	// The default minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup.
+	IncrementalBackupRetention types.Int64 `tfsdk:"incremental_backup_retention"`
+	// The default minimum amount of time to wait before updating cloud data with local changes.
+	WritebackFrequency types.Int64 `tfsdk:"writeback_frequency"`
+}
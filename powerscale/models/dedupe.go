@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DedupeSettingsResourceModel describes the dedupe settings resource data model.
+type DedupeSettingsResourceModel struct {
+	// The ID of the settings, always dedupe_settings.
+	ID types.String `tfsdk:"id"`
+	// The paths that will be deduplicated.
+	Paths types.List `tfsdk:"paths"`
+	// The paths that will be assessed for the space savings of deduplication.
+	AssessPaths types.List `tfsdk:"assess_paths"`
+	// Whether the Dedupe job is enabled.
+	DedupeEnabled types.Bool `tfsdk:"dedupe_enabled"`
+}
+
+// DedupeSummaryDataSourceModel describes the dedupe summary data source data model.
+type DedupeSummaryDataSourceModel struct {
+	ID      types.String        `tfsdk:"id"`
+	Summary *DedupeSummaryModel `tfsdk:"summary"`
+}
+
+// DedupeSummaryModel describes the space savings of deduplication.
+type DedupeSummaryModel struct {
+	// The size of a block in bytes.
+	BlockSize types.Int64 `tfsdk:"block_size"`
+	// The estimated number of physical blocks that deduplication would use.
+	EstimatedPhysicalBlocks types.Int64 `tfsdk:"estimated_physical_blocks"`
+	// The estimated number of blocks that deduplication would save.
+	EstimatedSavedBlocks types.Int64 `tfsdk:"estimated_saved_blocks"`
+	// The number of logical blocks of the deduplicated files.
+	LogicalBlocks types.Int64 `tfsdk:"logical_blocks"`
+	// The number of logical blocks saved by deduplication.
+	SavedLogicalBlocks types.Int64 `tfsdk:"saved_logical_blocks"`
+	// The total number of blocks of the cluster.
+	TotalBlocks types.Int64 `tfsdk:"total_blocks"`
+	// The number of blocks used on the cluster.
+	UsedBlocks types.Int64 `tfsdk:"used_blocks"`
+}
+
+// DedupeReportDataSourceModel describes the dedupe report data source data model.
+type DedupeReportDataSourceModel struct {
+	ID            types.String        `tfsdk:"id"`
+	DedupeReports []DedupeReportModel `tfsdk:"dedupe_reports"`
+	// filter
+	Filter *DedupeReportFilterType `tfsdk:"filter"`
+}
+
+// DedupeReportFilterType describes the filter data model.
+type DedupeReportFilterType struct {
+	JobID   types.Int64  `tfsdk:"job_id"`
+	JobType types.String `tfsdk:"job_type"`
+	Begin   types.Int64  `tfsdk:"begin"`
+	End     types.Int64  `tfsdk:"end"`
+}
+
+// DedupeReportModel describes a dedupe report.
+type DedupeReportModel struct {
+	// The system ID given to the report.
+	ID types.String `tfsdk:"id"`
+	// The ID of the job which generated the report.
+	JobID types.Int64 `tfsdk:"job_id"`
+	// The type of the job which generated the report.
+	JobType types.String `tfsdk:"job_type"`
+	// Time the report was created, in unix epoch seconds.
+	Time types.Int64 `tfsdk:"time"`
+}
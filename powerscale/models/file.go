@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FileResourceModel describes the file resource data model.
+type FileResourceModel struct {
+	// The path of the file, same as path.
+	ID types.String `tfsdk:"id"`
+	// The absolute path of the file, starting with /ifs.
+	Path types.String `tfsdk:"path"`
+	// The content of the file, as a UTF-8 string.
+	Content types.String `tfsdk:"content"`
+	// The content of the file, base64 encoded.
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	// The local path of a file to upload.
+	Source types.String `tfsdk:"source"`
+	// The SHA-256 checksum of the content of the file.
+	ContentSha256 types.String `tfsdk:"content_sha256"`
+	// Whether to overwrite an existing file when creating the resource.
+	Overwrite types.Bool `tfsdk:"overwrite"`
+	// The predefined ACL or the POSIX mode of the file.
+	AccessControl types.String `tfsdk:"access_control"`
+	// The name of the owner of the file.
+	Owner types.String `tfsdk:"owner"`
+	// The name of the group of the file.
+	Group types.String `tfsdk:"group"`
+	// The POSIX mode of the file.
+	Mode types.String `tfsdk:"mode"`
+	// The size of the file in bytes.
+	Size types.Int64 `tfsdk:"size"`
+	// The time the file was last modified.
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+// FileDataSourceModel describes the file data source data model.
+type FileDataSourceModel struct {
+	// The path of the file, same as path.
+	ID types.String `tfsdk:"id"`
+	// The absolute path of the file, starting with /ifs.
+	Path types.String `tfsdk:"path"`
+	// Whether to read the content of the file.
+	IncludeContent types.Bool `tfsdk:"include_content"`
+	// The content of the file, as a UTF-8 string.
+	Content types.String `tfsdk:"content"`
+	// The content of the file, base64 encoded.
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	// The SHA-256 checksum of the content of the file.
+	ContentSha256 types.String `tfsdk:"content_sha256"`
+	// The name of the owner of the file.
+	Owner types.String `tfsdk:"owner"`
+	// The name of the group of the file.
+	Group types.String `tfsdk:"group"`
+	// The POSIX mode of the file.
+	Mode types.String `tfsdk:"mode"`
+	// The size of the file in bytes.
+	Size types.Int64 `tfsdk:"size"`
+	// The time the file was last modified.
+	LastModified types.String `tfsdk:"last_modified"`
+	// The content type of the file.
+	ContentType types.String `tfsdk:"content_type"`
+}
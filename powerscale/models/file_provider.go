@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FileProviderResourceModel describes the file provider resource data model.
+type FileProviderResourceModel struct {
+	// The ID of the file provider, which is its name.
+	ID types.String `tfsdk:"id"`
+	// Specifies the name of the file provider.
+	Name types.String `tfsdk:"name"`
+	// Specifies the location of the file that contains information about users.
+	PasswordFile types.String `tfsdk:"password_file"`
+	// Specifies the location of the file that contains information about the group.
+	GroupFile types.String `tfsdk:"group_file"`
+	// Specifies the path to a netgroups replacement file.
+	NetgroupFile types.String `tfsdk:"netgroup_file"`
+	// If true, enables modification of the password file.
+	ModifiablePasswordFile types.Bool `tfsdk:"modifiable_password_file"`
+	// If true, enables modification of the group file.
+	ModifiableGroupFile types.Bool `tfsdk:"modifiable_group_file"`
+	// If true, enables authentication and identity management through the authentication provider.
+	Authentication types.Bool `tfsdk:"authentication"`
+	// If true, enables the file provider.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// Automatically create the home directory on the first login.
+	CreateHomeDirectory types.Bool `tfsdk:"create_home_directory"`
+	// Specifies the path to the home directory template.
+	HomeDirectoryTemplate types.String `tfsdk:"home_directory_template"`
+	// Specifies the login shell path.
+	LoginShell types.String `tfsdk:"login_shell"`
+	// If true, enables the provider to enumerate users.
+	EnumerateUsers types.Bool `tfsdk:"enumerate_users"`
+	// If true, enables the provider to enumerate groups.
+	EnumerateGroups types.Bool `tfsdk:"enumerate_groups"`
+	// Specifies the domain for this provider through which users are qualified.
+	UserDomain types.String `tfsdk:"user_domain"`
+	// Specifies the domain for this provider through which groups are qualified.
+	GroupDomain types.String `tfsdk:"group_domain"`
+	// Specifies the domain for the provider.
+	ProviderDomain types.String `tfsdk:"provider_domain"`
+	// Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.
+	NTLMSupport types.String `tfsdk:"ntlm_support"`
+	// Normalizes user names to lowercase before look up.
+	NormalizeUsers types.Bool `tfsdk:"normalize_users"`
+	// Normalizes group names to lowercase before look up.
+	NormalizeGroups types.Bool `tfsdk:"normalize_groups"`
+	// If true, checks the provider for filtered lists of findable and unfindable users and groups.
+	RestrictFindable types.Bool `tfsdk:"restrict_findable"`
+	// If true, checks the provider for filtered lists of listable and unlistable users and groups.
+	RestrictListable types.Bool `tfsdk:"restrict_listable"`
+	// Specifies the name of the access zone in which this provider was created.
+	ZoneName types.String `tfsdk:"zone_name"`
+	// Specifies the status of the provider.
+	Status types.String `tfsdk:"status"`
+}
+
+// FileProviderDataSourceModel describes the file provider data source data model.
+type FileProviderDataSourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	FileProviders []FileProviderDetailModel `tfsdk:"file_providers"`
+	// filter
+	Filter *FileProviderFilterType `tfsdk:"filter"`
+}
+
+// FileProviderFilterType describes the filter data model.
+type FileProviderFilterType struct {
+	Names []types.String `tfsdk:"names"`
+}
+
+// FileProviderDetailModel describes the details of a file provider.
+type FileProviderDetailModel struct {
+	// The ID of the file provider, which is its name.
+	ID types.String `tfsdk:"id"`
+	// Specifies the name of the file provider.
+	Name types.String `tfsdk:"name"`
+	// Specifies the location of the file that contains information about users.
+	PasswordFile types.String `tfsdk:"password_file"`
+	// Specifies the location of the file that contains information about the group.
+	GroupFile types.String `tfsdk:"group_file"`
+	// Specifies the path to a netgroups replacement file.
+	NetgroupFile types.String `tfsdk:"netgroup_file"`
+	// If true, enables modification of the password file.
+	ModifiablePasswordFile types.Bool `tfsdk:"modifiable_password_file"`
+	// If true, enables modification of the group file.
+	ModifiableGroupFile types.Bool `tfsdk:"modifiable_group_file"`
+	// If true, enables authentication and identity management through the authentication provider.
+	Authentication types.Bool `tfsdk:"authentication"`
+	// If true, enables the file provider.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// Automatically create the home directory on the first login.
+	CreateHomeDirectory types.Bool `tfsdk:"create_home_directory"`
+	// Specifies the path to the home directory template.
+	HomeDirectoryTemplate types.String `tfsdk:"home_directory_template"`
+	// Specifies the login shell path.
+	LoginShell types.String `tfsdk:"login_shell"`
+	// If true, enables the provider to enumerate users.
+	EnumerateUsers types.Bool `tfsdk:"enumerate_users"`
+	// If true, enables the provider to enumerate groups.
+	EnumerateGroups types.Bool `tfsdk:"enumerate_groups"`
+	// Specifies the domain for this provider through which users are qualified.
+	UserDomain types.String `tfsdk:"user_domain"`
+	// Specifies the domain for this provider through which groups are qualified.
+	GroupDomain types.String `tfsdk:"group_domain"`
+	// Specifies the domain for the provider.
+	ProviderDomain types.String `tfsdk:"provider_domain"`
+	// Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.
+	NTLMSupport types.String `tfsdk:"ntlm_support"`
+	// Normalizes user names to lowercase before look up.
+	NormalizeUsers types.Bool `tfsdk:"normalize_users"`
+	// Normalizes group names to lowercase before look up.
+	NormalizeGroups types.Bool `tfsdk:"normalize_groups"`
+	// If true, checks the provider for filtered lists of findable and unfindable users and groups.
+	RestrictFindable types.Bool `tfsdk:"restrict_findable"`
+	// If true, checks the provider for filtered lists of listable and unlistable users and groups.
+	RestrictListable types.Bool `tfsdk:"restrict_listable"`
+	// Specifies the name of the access zone in which this provider was created.
+	ZoneName types.String `tfsdk:"zone_name"`
+	// Specifies the status of the provider.
+	Status types.String `tfsdk:"status"`
+}
@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // FileSystemDataSourceModel describes the data source data model.
 type FileSystemDataSourceModel struct {
@@ -183,4 +186,16 @@ type FileSystemResource struct {
 	Recursive types.Bool `tfsdk:"recursive"`
 	// Deletes and replaces the existing user attributes and ACLs of the directory with user-specified attributes if set to true.
 	Overwrite types.Bool `tfsdk:"overwrite"`
+	// The requested protection of the directory, such as default, +2:1 or 3x.
+	RequestedProtection types.String `tfsdk:"requested_protection"`
+	// The access pattern of the directory: default, streaming or random.
+	AccessPattern types.String `tfsdk:"access_pattern"`
+	// The SSD strategy of the directory: metadata, metadata-write, data or avoid.
+	SSDStrategy types.String `tfsdk:"ssd_strategy"`
+	// The SmartPools tier or node pool the data of the directory is written to, or anywhere.
+	DiskPoolPolicy types.String `tfsdk:"disk_pool_policy"`
+	// What to do with the directory when the resource is destroyed: fail_if_not_empty, recursive or retain.
+	DeleteMode types.String `tfsdk:"delete_mode"`
+	// Timeouts of the create, update and delete operations.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FileSystemCopyResourceModel describes the file system copy resource data model.
+type FileSystemCopyResourceModel struct {
+	// The path of the copy, same as target_path.
+	ID types.String `tfsdk:"id"`
+	// The absolute path of the directory or file to copy.
+	SourcePath types.String `tfsdk:"source_path"`
+	// The absolute path of the copy.
+	TargetPath types.String `tfsdk:"target_path"`
+	// Whether to merge the source directory into an existing target directory.
+	Merge types.Bool `tfsdk:"merge"`
+	// Whether to overwrite the existing files of the target.
+	Overwrite types.Bool `tfsdk:"overwrite"`
+	// Whether to continue copying the remaining files after a file could not be copied.
+	ContinueOnError types.Bool `tfsdk:"continue_on_error"`
+	// Whether to clone the source file instead of copying its data.
+	Clone types.Bool `tfsdk:"clone"`
+	// The name of the snapshot to clone the source file from.
+	Snapshot types.String `tfsdk:"snapshot"`
+	// The type of the source, container for a directory and object for a file.
+	Type types.String `tfsdk:"type"`
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// IdentityMappingResourceModel describes the identity mapping resource data model.
+type IdentityMappingResourceModel struct {
+	// The ID of the identity mapping, in the form [<zone>:]<source>:<target>.
+	ID types.String `tfsdk:"id"`
+	// Specifies the source persona.
+	Source types.String `tfsdk:"source"`
+	// Specifies the target persona.
+	Target types.String `tfsdk:"target"`
+	// Specifies the access zone of the mapping.
+	Zone types.String `tfsdk:"zone"`
+	// If true, the reverse mapping from target to source is created as well.
+	TwoWay types.Bool `tfsdk:"two_way"`
+	// If true, existing mappings of the source are replaced.
+	Replace types.Bool `tfsdk:"replace"`
+	// Whether the target identity is stored on disk.
+	OnDisk types.Bool `tfsdk:"on_disk"`
+	// Specifies the type of the mapping.
+	Type types.String `tfsdk:"type"`
+}
+
+// IdentityMappingDataSourceModel describes the identity mapping data source data model.
+type IdentityMappingDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// Specifies the source persona to look up.
+	Source types.String `tfsdk:"source"`
+	// Specifies the access zone of the mapping.
+	Zone types.String `tfsdk:"zone"`
+	// The targets the source persona is mapped to.
+	Targets []IdentityMappingTargetModel `tfsdk:"targets"`
+}
+
+// IdentityMappingTargetModel describes a target of an identity mapping.
+type IdentityMappingTargetModel struct {
+	// Specifies the target persona.
+	Target types.String `tfsdk:"target"`
+	// Specifies the name of the target persona.
+	Name types.String `tfsdk:"name"`
+	// Whether the target identity is stored on disk.
+	OnDisk types.Bool `tfsdk:"on_disk"`
+	// Specifies the type of the mapping.
+	Type types.String `tfsdk:"type"`
+}
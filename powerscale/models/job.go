@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// JobResourceModel describes the Job Engine job resource data model.
+type JobResourceModel struct {
+	// The ID of the job.
+	ID types.String `tfsdk:"id"`
+	// The type of the job.
+	Type types.String `tfsdk:"type"`
+	// The paths the job operates on.
+	Paths types.List `tfsdk:"paths"`
+	// The impact policy of the job.
+	Policy types.String `tfsdk:"policy"`
+	// The priority of the job.
+	Priority types.Int64 `tfsdk:"priority"`
+	// Whether to start the job even if another job of the same type is running.
+	AllowDup types.Bool `tfsdk:"allow_dup"`
+	// Whether to wait for the job to complete.
+	WaitForCompletion types.Bool `tfsdk:"wait_for_completion"`
+	// The last observed state of the job.
+	State types.String `tfsdk:"state"`
+}
+
+// JobDataSourceModel describes the Job Engine job data source data model.
+type JobDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Jobs []JobModel   `tfsdk:"jobs"`
+	// filter
+	Filter *JobFilterType `tfsdk:"filter"`
+}
+
+// JobFilterType describes the filter data model.
+type JobFilterType struct {
+	State types.String   `tfsdk:"state"`
+	Types []types.String `tfsdk:"types"`
+	Sort  types.String   `tfsdk:"sort"`
+	Dir   types.String   `tfsdk:"dir"`
+	Limit types.Int64    `tfsdk:"limit"`
+	// Whether to also list the finished jobs recorded in the job events.
+	IncludeFinished types.Bool `tfsdk:"include_finished"`
+}
+
+// JobModel describes a job of the Job Engine.
+type JobModel struct {
+	// The ID of the job.
+	ID types.Int64 `tfsdk:"id"`
+	// The type of the job.
+	Type types.String `tfsdk:"type"`
+	// The state of the job.
+	State types.String `tfsdk:"state"`
+	// A description of the job.
+	Description types.String `tfsdk:"description"`
+	// The impact policy of the job.
+	Policy types.String `tfsdk:"policy"`
+	// The current impact level of the job.
+	Impact types.String `tfsdk:"impact"`
+	// The priority of the job.
+	Priority types.Int64 `tfsdk:"priority"`
+	// The current phase of the job.
+	CurrentPhase types.Int64 `tfsdk:"current_phase"`
+	// The total number of phases of the job.
+	TotalPhases types.Int64 `tfsdk:"total_phases"`
+	// The progress of the current phase of the job.
+	Progress types.String `tfsdk:"progress"`
+	// The Unix Epoch time the job was created.
+	CreateTime types.Int64 `tfsdk:"create_time"`
+	// The Unix Epoch time the job was started.
+	StartTime types.Int64 `tfsdk:"start_time"`
+	// The number of seconds the job has been running.
+	RunningTime types.Int64 `tfsdk:"running_time"`
+	// The Unix Epoch time the job ended, only known for finished jobs.
+	EndTime types.Int64 `tfsdk:"end_time"`
+	// The paths the job operates on.
+	Paths types.List `tfsdk:"paths"`
+}
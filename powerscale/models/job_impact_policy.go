@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// JobImpactPolicyResourceModel describes the Job Engine impact policy resource data model.
+type JobImpactPolicyResourceModel struct {
+	// The ID of the impact policy.
+	ID types.String `tfsdk:"id"`
+	// The name of the impact policy.
+	Name types.String `tfsdk:"name"`
+	// The description of the impact policy.
+	Description types.String `tfsdk:"description"`
+	// The time intervals of the impact policy.
+	Intervals types.List `tfsdk:"intervals"`
+	// Whether the impact policy is a system policy.
+	System types.Bool `tfsdk:"system"`
+}
+
+// JobImpactPolicyIntervalModel describes a time interval of an impact policy.
+type JobImpactPolicyIntervalModel struct {
+	// The start of the interval, in the format "<day> hh:mm".
+	Begin types.String `tfsdk:"begin"`
+	// The end of the interval, in the format "<day> hh:mm".
+	End types.String `tfsdk:"end"`
+	// The impact level during the interval.
+	Impact types.String `tfsdk:"impact"`
+}
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// JobTypeResourceModel describes the Job Engine job type resource data model.
+type JobTypeResourceModel struct {
+	// The name of the job type.
+	ID types.String `tfsdk:"id"`
+	// Whether the job type is enabled.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// The default priority of jobs of this type.
+	Priority types.Int64 `tfsdk:"priority"`
+	// The default impact policy of jobs of this type.
+	Policy types.String `tfsdk:"policy"`
+	// The schedule of the job type.
+	Schedule types.String `tfsdk:"schedule"`
+	// The description of the job type.
+	Description types.String `tfsdk:"description"`
+}
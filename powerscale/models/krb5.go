@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Krb5RealmResourceModel describes the Kerberos realm resource data model.
+type Krb5RealmResourceModel struct {
+	// The ID of the Kerberos realm.
+	ID types.String `tfsdk:"id"`
+	// Specifies the name of the realm.
+	Realm types.String `tfsdk:"realm"`
+	// Specifies the list of KDC addresses of the realm.
+	Kdc types.List `tfsdk:"kdc"`
+	// Specifies the administrative server hostname of the realm.
+	AdminServer types.String `tfsdk:"admin_server"`
+	// Specifies the default domain mapped to the realm.
+	DefaultDomain types.String `tfsdk:"default_domain"`
+	// If true, indicates that the realm is the default realm.
+	IsDefaultRealm types.Bool `tfsdk:"is_default_realm"`
+}
+
+// Krb5DomainResourceModel describes the Kerberos domain resource data model.
+type Krb5DomainResourceModel struct {
+	// The ID of the Kerberos domain.
+	ID types.String `tfsdk:"id"`
+	// Specifies the name of the domain.
+	Domain types.String `tfsdk:"domain"`
+	// Specifies the name of the realm the domain is mapped to.
+	Realm types.String `tfsdk:"realm"`
+}
+
+// Krb5ProviderResourceModel describes the Kerberos provider resource data model.
+type Krb5ProviderResourceModel struct {
+	// The ID of the Kerberos provider, which is its name.
+	ID types.String `tfsdk:"id"`
+	// Specifies the Kerberos provider name.
+	Name types.String `tfsdk:"name"`
+	// Specifies the name of the realm.
+	Realm types.String `tfsdk:"realm"`
+	// Specifies the user name that has permission to join a machine to the given Kerberos realm.
+	User types.String `tfsdk:"user"`
+	// Specifies the password used for authenticating to the Kerberos realm.
+	Password types.String `tfsdk:"password"`
+	// Specifies the path to a keytab file to import.
+	KeytabFile types.String `tfsdk:"keytab_file"`
+	// Specifies the key information for the Kerberos SPNs of the provider.
+	KeytabEntries types.List `tfsdk:"keytab_entries"`
+	// If true, keys are managed manually. If false, keys are managed through kadmin.
+	ManualKeying types.Bool `tfsdk:"manual_keying"`
+	// Groupnet identifier.
+	Groupnet types.String `tfsdk:"groupnet"`
+	// Specifies the status of the provider.
+	Status types.String `tfsdk:"status"`
+}
+
+// Krb5SettingsResourceModel describes the Kerberos settings resource data model.
+type Krb5SettingsResourceModel struct {
+	// The ID of the Kerberos settings.
+	ID types.String `tfsdk:"id"`
+	// If true, sends preauth for every request.
+	AlwaysSendPreauth types.Bool `tfsdk:"always_send_preauth"`
+	// Specifies the realm used when a principal does not specify one.
+	DefaultRealm types.String `tfsdk:"default_realm"`
+	// If true, looks up the KDCs of a realm in DNS.
+	DNSLookupKdc types.Bool `tfsdk:"dns_lookup_kdc"`
+	// If true, looks up the realm of a host in DNS.
+	DNSLookupRealm types.Bool `tfsdk:"dns_lookup_realm"`
+	// Specifies the number of retries to a KDC.
+	KdcRetries types.Int64 `tfsdk:"kdc_retries"`
+	// Specifies the timeout of a KDC request in seconds.
+	KdcTimeout types.Int64 `tfsdk:"kdc_timeout"`
+	// Specifies the preferred encryption types.
+	PreferredEncTypes types.List `tfsdk:"preferred_enc_types"`
+}
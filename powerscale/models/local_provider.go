@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// LocalProviderResourceModel describes the local provider resource data model.
+type LocalProviderResourceModel struct {
+	// The ID of the local provider, which is its name.
+	ID types.String `tfsdk:"id"`
+	// Specifies the local provider name, which is the name of its access zone.
+	Name types.String `tfsdk:"name"`
+	// If true, enables authentication and identity management through the authentication provider.
+	Authentication types.Bool `tfsdk:"authentication"`
+	// Automatically create the home directory on the first login.
+	CreateHomeDirectory types.Bool `tfsdk:"create_home_directory"`
+	// Specifies the path to the home directory template.
+	HomeDirectoryTemplate types.String `tfsdk:"home_directory_template"`
+	// Specifies the login shell path.
+	LoginShell types.String `tfsdk:"login_shell"`
+	// Specifies the domain for this provider through which users and groups are qualified.
+	MachineName types.String `tfsdk:"machine_name"`
+	// Specifies the length of time in seconds that an account will be inaccessible after multiple failed login attempts.
+	LockoutDuration types.Int64 `tfsdk:"lockout_duration"`
+	// Specifies the number of failed login attempts necessary before an account is locked.
+	LockoutThreshold types.Int64 `tfsdk:"lockout_threshold"`
+	// Specifies the duration of time in seconds in which the number of failed attempts set in lockout_threshold must be made for an account to be locked.
+	LockoutWindow types.Int64 `tfsdk:"lockout_window"`
+	// Specifies the maximum password age in seconds.
+	MaxPasswordAge types.Int64 `tfsdk:"max_password_age"`
+	// Specifies the minimum password age in seconds.
+	MinPasswordAge types.Int64 `tfsdk:"min_password_age"`
+	// Specifies the minimum password length.
+	MinPasswordLength types.Int64 `tfsdk:"min_password_length"`
+	// List of cases required in a password. Options are lowercase, uppercase, numeric and symbol.
+	PasswordComplexity types.List `tfsdk:"password_complexity"`
+	// Specifies the number of previous passwords to store.
+	PasswordHistoryLength types.Int64 `tfsdk:"password_history_length"`
+	// Specifies the time in seconds remaining before a user will be prompted for a password change.
+	PasswordPromptTime types.Int64 `tfsdk:"password_prompt_time"`
+	// Specifies the name of the access zone in which this provider was created.
+	ZoneName types.String `tfsdk:"zone_name"`
+	// Specifies the status of the provider.
+	Status types.String `tfsdk:"status"`
+}
+
+// LocalProviderDataSourceModel describes the local provider data source data model.
+type LocalProviderDataSourceModel struct {
+	ID             types.String               `tfsdk:"id"`
+	LocalProviders []LocalProviderDetailModel `tfsdk:"local_providers"`
+	// filter
+	Filter *LocalProviderFilterType `tfsdk:"filter"`
+}
+
+// LocalProviderFilterType describes the filter data model.
+type LocalProviderFilterType struct {
+	Names []types.String `tfsdk:"names"`
+}
+
+// LocalProviderDetailModel describes the details of a local provider.
+type LocalProviderDetailModel struct {
+	// The ID of the local provider, which is its name.
+	ID types.String `tfsdk:"id"`
+	// Specifies the local provider name, which is the name of its access zone.
+	Name types.String `tfsdk:"name"`
+	// If true, enables authentication and identity management through the authentication provider.
+	Authentication types.Bool `tfsdk:"authentication"`
+	// Automatically create the home directory on the first login.
+	CreateHomeDirectory types.Bool `tfsdk:"create_home_directory"`
+	// Specifies the path to the home directory template.
+	HomeDirectoryTemplate types.String `tfsdk:"home_directory_template"`
+	// Specifies the login shell path.
+	LoginShell types.String `tfsdk:"login_shell"`
+	// Specifies the domain for this provider through which users and groups are qualified.
+	MachineName types.String `tfsdk:"machine_name"`
+	// Specifies the length of time in seconds that an account will be inaccessible after multiple failed login attempts.
+	LockoutDuration types.Int64 `tfsdk:"lockout_duration"`
+	// Specifies the number of failed login attempts necessary before an account is locked.
+	LockoutThreshold types.Int64 `tfsdk:"lockout_threshold"`
+	// Specifies the duration of time in seconds in which the number of failed attempts set in lockout_threshold must be made for an account to be locked.
+	LockoutWindow types.Int64 `tfsdk:"lockout_window"`
+	// Specifies the maximum password age in seconds.
+	MaxPasswordAge types.Int64 `tfsdk:"max_password_age"`
+	// Specifies the minimum password age in seconds.
+	MinPasswordAge types.Int64 `tfsdk:"min_password_age"`
+	// Specifies the minimum password length.
+	MinPasswordLength types.Int64 `tfsdk:"min_password_length"`
+	// List of cases required in a password. Options are lowercase, uppercase, numeric and symbol.
+	PasswordComplexity types.List `tfsdk:"password_complexity"`
+	// Specifies the number of previous passwords to store.
+	PasswordHistoryLength types.Int64 `tfsdk:"password_history_length"`
+	// Specifies the time in seconds remaining before a user will be prompted for a password change.
+	PasswordPromptTime types.Int64 `tfsdk:"password_prompt_time"`
+	// Specifies the name of the access zone in which this provider was created.
+	ZoneName types.String `tfsdk:"zone_name"`
+	// Specifies the status of the provider.
+	Status types.String `tfsdk:"status"`
+}
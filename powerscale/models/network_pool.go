@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // NetworkPoolDataSourceModel describes the data source data model.
 type NetworkPoolDataSourceModel struct {
@@ -153,4 +156,6 @@ type NetworkPoolResourceModel struct {
 	StaticRoutes types.List `tfsdk:"static_routes"`
 	// The name of the subnet.
 	Subnet types.String `tfsdk:"subnet"`
+	// Timeouts of the create, update and delete operations.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NISProviderResourceModel describes the NIS provider resource data model.
+type NISProviderResourceModel struct {
+	// The ID of the NIS provider, which is its name.
+	ID types.String `tfsdk:"id"`
+	// Specifies the NIS provider name.
+	Name types.String `tfsdk:"name"`
+	// Specifies the NIS domain name.
+	NISDomain types.String `tfsdk:"nis_domain"`
+	// Specifies the NIS servers to be used for authentication lookups.
+	Servers types.List `tfsdk:"servers"`
+	// Groupnet identifier.
+	Groupnet types.String `tfsdk:"groupnet"`
+	// If true, enables authentication and identity management through the authentication provider.
+	Authentication types.Bool `tfsdk:"authentication"`
+	// If true, enables the NIS provider.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// If true, connects the provider to a random server.
+	BalanceServers types.Bool `tfsdk:"balance_servers"`
+	// Specifies the time in seconds between provider online checks.
+	CheckOnlineInterval types.Int64 `tfsdk:"check_online_interval"`
+	// Specifies the request timeout interval in seconds.
+	RequestTimeout types.Int64 `tfsdk:"request_timeout"`
+	// Specifies the timeout period in seconds after which a request will be retried.
+	RetryTime types.Int64 `tfsdk:"retry_time"`
+	// If true, enables host name look ups.
+	HostnameLookup types.Bool `tfsdk:"hostname_lookup"`
+	// Automatically create the home directory on the first login.
+	CreateHomeDirectory types.Bool `tfsdk:"create_home_directory"`
+	// Specifies the path to the home directory template.
+	HomeDirectoryTemplate types.String `tfsdk:"home_directory_template"`
+	// Specifies the login shell path.
+	LoginShell types.String `tfsdk:"login_shell"`
+	// If true, enables the provider to enumerate users.
+	EnumerateUsers types.Bool `tfsdk:"enumerate_users"`
+	// If true, enables the provider to enumerate groups.
+	EnumerateGroups types.Bool `tfsdk:"enumerate_groups"`
+	// Specifies the domain for this provider through which users are qualified.
+	UserDomain types.String `tfsdk:"user_domain"`
+	// Specifies the domain for this provider through which groups are qualified.
+	GroupDomain types.String `tfsdk:"group_domain"`
+	// Specifies the domain for the provider.
+	ProviderDomain types.String `tfsdk:"provider_domain"`
+	// Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.
+	NTLMSupport types.String `tfsdk:"ntlm_support"`
+	// Normalizes user names to lowercase before look up.
+	NormalizeUsers types.Bool `tfsdk:"normalize_users"`
+	// Normalizes group names to lowercase before look up.
+	NormalizeGroups types.Bool `tfsdk:"normalize_groups"`
+	// If true, checks the provider for filtered lists of findable and unfindable users and groups.
+	RestrictFindable types.Bool `tfsdk:"restrict_findable"`
+	// If true, checks the provider for filtered lists of listable and unlistable users and groups.
+	RestrictListable types.Bool `tfsdk:"restrict_listable"`
+	// Specifies the name of the access zone in which this provider was created.
+	ZoneName types.String `tfsdk:"zone_name"`
+	// Specifies the status of the provider.
+	Status types.String `tfsdk:"status"`
+}
+
+// NISProviderDataSourceModel describes the NIS provider data source data model.
+type NISProviderDataSourceModel struct {
+	ID           types.String             `tfsdk:"id"`
+	NISProviders []NISProviderDetailModel `tfsdk:"nis_providers"`
+	// filter
+	Filter *NISProviderFilterType `tfsdk:"filter"`
+}
+
+// NISProviderFilterType describes the filter data model.
+type NISProviderFilterType struct {
+	Names []types.String `tfsdk:"names"`
+}
+
+// NISProviderDetailModel describes the details of a NIS provider.
+type NISProviderDetailModel struct {
+	// The ID of the NIS provider, which is its name.
+	ID types.String `tfsdk:"id"`
+	// Specifies the NIS provider name.
+	Name types.String `tfsdk:"name"`
+	// Specifies the NIS domain name.
+	NISDomain types.String `tfsdk:"nis_domain"`
+	// Specifies the NIS servers to be used for authentication lookups.
+	Servers types.List `tfsdk:"servers"`
+	// Groupnet identifier.
+	Groupnet types.String `tfsdk:"groupnet"`
+	// If true, enables authentication and identity management through the authentication provider.
+	Authentication types.Bool `tfsdk:"authentication"`
+	// If true, enables the NIS provider.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// If true, connects the provider to a random server.
+	BalanceServers types.Bool `tfsdk:"balance_servers"`
+	// Specifies the time in seconds between provider online checks.
+	CheckOnlineInterval types.Int64 `tfsdk:"check_online_interval"`
+	// Specifies the request timeout interval in seconds.
+	RequestTimeout types.Int64 `tfsdk:"request_timeout"`
+	// Specifies the timeout period in seconds after which a request will be retried.
+	RetryTime types.Int64 `tfsdk:"retry_time"`
+	// If true, enables host name look ups.
+	HostnameLookup types.Bool `tfsdk:"hostname_lookup"`
+	// Automatically create the home directory on the first login.
+	CreateHomeDirectory types.Bool `tfsdk:"create_home_directory"`
+	// Specifies the path to the home directory template.
+	HomeDirectoryTemplate types.String `tfsdk:"home_directory_template"`
+	// Specifies the login shell path.
+	LoginShell types.String `tfsdk:"login_shell"`
+	// If true, enables the provider to enumerate users.
+	EnumerateUsers types.Bool `tfsdk:"enumerate_users"`
+	// If true, enables the provider to enumerate groups.
+	EnumerateGroups types.Bool `tfsdk:"enumerate_groups"`
+	// Specifies the domain for this provider through which users are qualified.
+	UserDomain types.String `tfsdk:"user_domain"`
+	// Specifies the domain for this provider through which groups are qualified.
+	GroupDomain types.String `tfsdk:"group_domain"`
+	// Specifies the domain for the provider.
+	ProviderDomain types.String `tfsdk:"provider_domain"`
+	// Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.
+	NTLMSupport types.String `tfsdk:"ntlm_support"`
+	// Normalizes user names to lowercase before look up.
+	NormalizeUsers types.Bool `tfsdk:"normalize_users"`
+	// Normalizes group names to lowercase before look up.
+	NormalizeGroups types.Bool `tfsdk:"normalize_groups"`
+	// If true, checks the provider for filtered lists of findable and unfindable users and groups.
+	RestrictFindable types.Bool `tfsdk:"restrict_findable"`
+	// If true, checks the provider for filtered lists of listable and unlistable users and groups.
+	RestrictListable types.Bool `tfsdk:"restrict_listable"`
+	// Specifies the name of the access zone in which this provider was created.
+	ZoneName types.String `tfsdk:"zone_name"`
+	// Specifies the status of the provider.
+	Status types.String `tfsdk:"status"`
+}
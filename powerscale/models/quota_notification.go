@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QuotaNotificationResourceModel describes the notification rule of a quota.
+type QuotaNotificationResourceModel struct {
+	// The system ID given to the notification rule.
+	ID types.String `tfsdk:"id"`
+	// The ID of the quota the notification rule belongs to.
+	QuotaID types.String `tfsdk:"quota_id"`
+	// The quota threshold detected.
+	Threshold types.String `tfsdk:"threshold"`
+	// The condition detected.
+	Condition types.String `tfsdk:"condition"`
+	// The schedule of the notifications sent while the condition persists.
+	Schedule types.String `tfsdk:"schedule"`
+	// Time to wait between detections, in seconds.
+	Holdoff types.Int64 `tfsdk:"holdoff"`
+	// Send alert when rule matches.
+	ActionAlert types.Bool `tfsdk:"action_alert"`
+	// Email the quota owner when rule matches.
+	ActionEmailOwner types.Bool `tfsdk:"action_email_owner"`
+	// Email addresses notified when rule matches.
+	ActionEmailAddress types.List `tfsdk:"action_email_address"`
+	// Path of the optional email template.
+	EmailTemplate types.String `tfsdk:"email_template"`
+}
+
+// QuotaDefaultNotificationResourceModel describes a global default notification rule, applied to the quotas without custom rules.
+type QuotaDefaultNotificationResourceModel struct {
+	// The system ID given to the notification rule.
+	ID types.String `tfsdk:"id"`
+	// The quota threshold detected.
+	Threshold types.String `tfsdk:"threshold"`
+	// The condition detected.
+	Condition types.String `tfsdk:"condition"`
+	// The schedule of the notifications sent while the condition persists.
+	Schedule types.String `tfsdk:"schedule"`
+	// Time to wait between detections, in seconds.
+	Holdoff types.Int64 `tfsdk:"holdoff"`
+	// Send alert when rule matches.
+	ActionAlert types.Bool `tfsdk:"action_alert"`
+	// Email the quota owner when rule matches.
+	ActionEmailOwner types.Bool `tfsdk:"action_email_owner"`
+	// Email addresses notified when rule matches.
+	ActionEmailAddress types.List `tfsdk:"action_email_address"`
+	// Path of the optional email template.
+	EmailTemplate types.String `tfsdk:"email_template"`
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QuotaReportResourceModel describes the on-demand quota report resource data model.
+type QuotaReportResourceModel struct {
+	// The system ID given to the report.
+	ID types.String `tfsdk:"id"`
+	// Path of the report file.
+	File types.String `tfsdk:"file"`
+	// Method used to generate the report.
+	Generated types.String `tfsdk:"generated"`
+	// Time the report was created, in unix epoch seconds.
+	Time types.Int64 `tfsdk:"time"`
+	// Whether the report is a summary or a detailed report.
+	Type types.String `tfsdk:"type"`
+}
+
+// QuotaReportDataSourceModel describes the quota report data source data model.
+type QuotaReportDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	QuotaReports []QuotaReportModel `tfsdk:"quota_reports"`
+	// filter
+	Filter *QuotaReportFilterType `tfsdk:"filter"`
+}
+
+// QuotaReportFilterType describes the filter data model.
+type QuotaReportFilterType struct {
+	Generated types.String `tfsdk:"generated"`
+	Type      types.String `tfsdk:"type"`
+}
+
+// QuotaReportModel describes a quota report.
+type QuotaReportModel struct {
+	// The system ID given to the report.
+	ID types.String `tfsdk:"id"`
+	// Path of the report file.
+	File types.String `tfsdk:"file"`
+	// Method used to generate the report.
+	Generated types.String `tfsdk:"generated"`
+	// Time the report was created, in unix epoch seconds.
+	Time types.Int64 `tfsdk:"time"`
+	// Whether the report is a summary or a detailed report.
+	Type types.String `tfsdk:"type"`
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QuotaReportSettingsResourceModel describes the quota report settings resource data model.
+type QuotaReportSettingsResourceModel struct {
+	// The directory on /ifs where manual or live reports will be placed.
+	LiveDir types.String `tfsdk:"live_dir"`
+	// The number of manual reports to keep.
+	LiveRetain types.Int64 `tfsdk:"live_retain"`
+	// The isidate schedule used to generate reports.
+	Schedule types.String `tfsdk:"schedule"`
+	// The directory on /ifs where schedule reports will be placed.
+	ScheduledDir types.String `tfsdk:"scheduled_dir"`
+	// The number of scheduled reports to keep.
+	ScheduledRetain types.Int64 `tfsdk:"scheduled_retain"`
+}
+
+// QuotaMappingSettingsResourceModel describes the quota mapping settings resource data model.
+type QuotaMappingSettingsResourceModel struct {
+	// The mappings of the domains to email domains.
+	Mappings []QuotaMappingModel `tfsdk:"mappings"`
+}
+
+// QuotaMappingModel describes the mapping of a domain to an email domain.
+type QuotaMappingModel struct {
+	// The FQDN of the source domain to map.
+	Domain types.String `tfsdk:"domain"`
+	// The FQDN of the destination domain to map to.
+	Mapping types.String `tfsdk:"mapping"`
+	// The type of the source domain.
+	Type types.String `tfsdk:"type"`
+}
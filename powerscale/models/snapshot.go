@@ -29,7 +29,13 @@ type SnapshotDataSourceModel struct {
 
 // SnapshotFilterType describes the filter data model.
 type SnapshotFilterType struct {
-	Path types.String `tfsdk:"path"`
+	Path     types.String `tfsdk:"path"`
+	Schedule types.String `tfsdk:"schedule"`
+	State    types.String `tfsdk:"state"`
+	Type     types.String `tfsdk:"type"`
+	Sort     types.String `tfsdk:"sort"`
+	Dir      types.String `tfsdk:"dir"`
+	Limit    types.Int64  `tfsdk:"limit"`
 }
 
 // SnapshotDetailModel details of the individual snapshot.
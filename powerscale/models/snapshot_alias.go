@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SnapshotAliasResourceModel describes the snapshot alias resource data model.
+type SnapshotAliasResourceModel struct {
+	// The system ID given to the snapshot alias.
+	ID types.String `tfsdk:"id"`
+	// The user supplied snapshot alias name.
+	Name types.String `tfsdk:"name"`
+	// The name or ID of the snapshot the alias points to.
+	Target types.String `tfsdk:"target"`
+	// The ID of the snapshot pointed to by the alias.
+	TargetID types.Int64 `tfsdk:"target_id"`
+	// The name of the snapshot pointed to by the alias.
+	TargetName types.String `tfsdk:"target_name"`
+}
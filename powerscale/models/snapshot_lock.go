@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SnapshotLockResourceModel describes the snapshot lock resource data model.
+type SnapshotLockResourceModel struct {
+	// The system ID given to the lock.
+	ID types.String `tfsdk:"id"`
+	// The name or ID of the locked snapshot.
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+	// User supplied lock comment.
+	Comment types.String `tfsdk:"comment"`
+	// The Unix Epoch time the lock will expire and be eligible for automatic deletion.
+	Expires types.Int64 `tfsdk:"expires"`
+	// The number of times this lock has been created.
+	LockCount types.Int64 `tfsdk:"lock_count"`
+}
@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// StoragepoolTierResourceModel describes the storage pool tier resource data model.
+type StoragepoolTierResourceModel struct {
+	// The system ID given to the tier.
+	ID types.String `tfsdk:"id"`
+	// The tier name.
+	Name types.String `tfsdk:"name"`
+	// The names of the node pools in the tier.
+	Children types.List `tfsdk:"children"`
+	// The percentage of the tier capacity that SmartPools will fill before transferring data to other pools.
+	TransferLimitPct types.Int64 `tfsdk:"transfer_limit_pct"`
+	// How the transfer limit of the tier is applied.
+	TransferLimitState types.String `tfsdk:"transfer_limit_state"`
+	// The nodes of the tier.
+	Lnns types.List `tfsdk:"lnns"`
+}
+
+// StoragepoolNodepoolResourceModel describes the storage pool node pool resource data model.
+type StoragepoolNodepoolResourceModel struct {
+	// The system ID given to the node pool.
+	ID types.String `tfsdk:"id"`
+	// The node pool name.
+	Name types.String `tfsdk:"name"`
+	// The nodes of a manual node pool.
+	Lnns types.List `tfsdk:"lnns"`
+	// The protection policy of the node pool.
+	ProtectionPolicy types.String `tfsdk:"protection_policy"`
+	// Whether the SSDs of the node pool are used as L3 cache.
+	L3 types.Bool `tfsdk:"l3"`
+	// The percentage of the node pool capacity that SmartPools will fill before transferring data to other pools.
+	TransferLimitPct types.Int64 `tfsdk:"transfer_limit_pct"`
+	// How the transfer limit of the node pool is applied.
+	TransferLimitState types.String `tfsdk:"transfer_limit_state"`
+	// Whether the node pool is a manual node pool.
+	Manual types.Bool `tfsdk:"manual"`
+	// The ID of the tier the node pool belongs to.
+	Tier types.String `tfsdk:"tier"`
+}
+
+// StoragepoolDataSourceModel describes the storage pool data source data model.
+type StoragepoolDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	Storagepools []StoragepoolModel `tfsdk:"storagepools"`
+	// filter
+	Filter *StoragepoolFilterType `tfsdk:"filter"`
+}
+
+// StoragepoolFilterType describes the filter data model.
+type StoragepoolFilterType struct {
+	Names []types.String `tfsdk:"names"`
+	Type  types.String   `tfsdk:"type"`
+}
+
+// StoragepoolModel describes a storage pool, which is either a node pool or a tier.
+type StoragepoolModel struct {
+	// The names of the node pools in the tier.
+	Children types.List `tfsdk:"children"`
+	// The system ID given to the storage pool.
+	ID types.Int64 `tfsdk:"id"`
+	// Whether the SSDs of the node pool are used as L3 cache.
+	L3 types.Bool `tfsdk:"l3"`
+	// The nodes of the storage pool.
+	Lnns types.List `tfsdk:"lnns"`
+	// Whether the node pool is a manual node pool.
+	Manual types.Bool `tfsdk:"manual"`
+	// The storage pool name.
+	Name types.String `tfsdk:"name"`
+	// The protection policy of the node pool.
+	ProtectionPolicy types.String `tfsdk:"protection_policy"`
+	// Whether the storage pool is a node pool or a tier.
+	Type types.String `tfsdk:"type"`
+	// The usage of the storage pool.
+	Usage *StoragepoolUsageModel `tfsdk:"usage"`
+}
+
+// StoragepoolUsageModel describes the usage of a storage pool.
+type StoragepoolUsageModel struct {
+	AvailBytes           types.String `tfsdk:"avail_bytes"`
+	AvailSsdBytes        types.String `tfsdk:"avail_ssd_bytes"`
+	Balanced             types.Bool   `tfsdk:"balanced"`
+	FreeBytes            types.String `tfsdk:"free_bytes"`
+	FreeSsdBytes         types.String `tfsdk:"free_ssd_bytes"`
+	PctUsed              types.String `tfsdk:"pct_used"`
+	TotalBytes           types.String `tfsdk:"total_bytes"`
+	TotalSsdBytes        types.String `tfsdk:"total_ssd_bytes"`
+	UsedBytes            types.String `tfsdk:"used_bytes"`
+	UsedSsdBytes         types.String `tfsdk:"used_ssd_bytes"`
+	VirtualHotSpareBytes types.String `tfsdk:"virtual_hot_spare_bytes"`
+}
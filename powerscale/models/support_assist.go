@@ -20,22 +20,24 @@ package models
 import (
 	powerscale "dell/powerscale-go-client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // SupportAssistModel represents the model for support assist resource.
 type SupportAssistModel struct {
-	ID                    types.String `tfsdk:"id"`
-	EnableDownload        types.Bool   `tfsdk:"enable_download"`
-	Contact               types.Object `tfsdk:"contact"`
-	Telemetry             types.Object `tfsdk:"telemetry"`
-	AutomaticCaseCreation types.Bool   `tfsdk:"automatic_case_creation"`
-	Connection            types.Object `tfsdk:"connections"`
-	EnableRemoteSupport   types.Bool   `tfsdk:"enable_remote_support"`
-	Accepted              types.Bool   `tfsdk:"accepted_terms"`
-	SupportassistEnabled  types.Bool   `tfsdk:"supportassist_enabled"`
-	AccessKey             types.String `tfsdk:"access_key"`
-	Pin                   types.String `tfsdk:"pin"`
+	ID                    types.String   `tfsdk:"id"`
+	EnableDownload        types.Bool     `tfsdk:"enable_download"`
+	Contact               types.Object   `tfsdk:"contact"`
+	Telemetry             types.Object   `tfsdk:"telemetry"`
+	AutomaticCaseCreation types.Bool     `tfsdk:"automatic_case_creation"`
+	Connection            types.Object   `tfsdk:"connections"`
+	EnableRemoteSupport   types.Bool     `tfsdk:"enable_remote_support"`
+	Accepted              types.Bool     `tfsdk:"accepted_terms"`
+	SupportassistEnabled  types.Bool     `tfsdk:"supportassist_enabled"`
+	AccessKey             types.String   `tfsdk:"access_key"`
+	Pin                   types.String   `tfsdk:"pin"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 // V16SupportassistSettingsCustomised represents the customized settings for the support assist.
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SyncIQJobResource defines the model of a SyncIQ job resource.
+type SyncIQJobResource struct {
+	// ID is the ID of the job, which is the ID of the policy it runs.
+	ID types.String `tfsdk:"id"`
+	// PolicyID is the ID or name of the policy to run the job for.
+	PolicyID types.String `tfsdk:"policy_id"`
+	// Action is the action the job performs.
+	Action types.String `tfsdk:"action"`
+	// SourceSnapshot is the snapshot to use as the source of the job.
+	SourceSnapshot types.String `tfsdk:"source_snapshot"`
+	// WorkersPerNode is the number of workers per node to use for the job.
+	WorkersPerNode types.Int64 `tfsdk:"workers_per_node"`
+	// WaitForCompletion indicates whether to wait for the job to finish.
+	WaitForCompletion types.Bool `tfsdk:"wait_for_completion"`
+	// State is the last observed state of the job.
+	State types.String `tfsdk:"state"`
+	// Report is the report of the finished job.
+	Report types.Object `tfsdk:"report"`
+	// Timeouts of the create operation, which bounds the wait for the job.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// SyncIQJobReportModel defines the model of a SyncIQ job report.
+type SyncIQJobReportModel struct {
+	// ID is the ID of the report.
+	ID types.String `tfsdk:"id"`
+	// JobID is the ID of the job the report belongs to.
+	JobID types.Int64 `tfsdk:"job_id"`
+	// PolicyID is the ID of the policy.
+	PolicyID types.String `tfsdk:"policy_id"`
+	// PolicyName is the name of the policy.
+	PolicyName types.String `tfsdk:"policy_name"`
+	// Action is the action performed by the job.
+	Action types.String `tfsdk:"action"`
+	// State is the state of the job.
+	State types.String `tfsdk:"state"`
+	// StartTime is the time the job started in unix epoch seconds.
+	StartTime types.Int64 `tfsdk:"start_time"`
+	// EndTime is the time the job ended in unix epoch seconds.
+	EndTime types.Int64 `tfsdk:"end_time"`
+	// Duration is the amount of time in seconds between job start and end.
+	Duration types.Int64 `tfsdk:"duration"`
+	// TotalFiles is the number of files affected by the job.
+	TotalFiles types.Int64 `tfsdk:"total_files"`
+	// FilesTransferred is the number of files transferred by the job.
+	FilesTransferred types.Int64 `tfsdk:"files_transferred"`
+	// BytesTransferred is the number of bytes transferred by the job.
+	BytesTransferred types.Int64 `tfsdk:"bytes_transferred"`
+	// Errors is the list of errors encountered by the job.
+	Errors types.List `tfsdk:"errors"`
+	// Warnings is the list of warnings encountered by the job.
+	Warnings types.List `tfsdk:"warnings"`
+}
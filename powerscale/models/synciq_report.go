@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SyncIQReportDataSource defines the SyncIQ report data source implementation.
+// It is shared by the source and target report data sources.
+type SyncIQReportDataSource struct {
+	// ID is the unique identifier of the data source.
+	ID types.String `tfsdk:"id"`
+	// Reports is a list of SyncIQ reports.
+	Reports []SyncIQReportModel `tfsdk:"reports"`
+	// Filter is the filter of the data source.
+	Filter *SyncIQReportFilterType `tfsdk:"filter"`
+}
+
+// SyncIQReportFilterType describes the filter data model.
+type SyncIQReportFilterType struct {
+	// PolicyName filters the reports by policy name.
+	PolicyName types.String `tfsdk:"policy_name"`
+	// State filters the reports by job state.
+	State types.String `tfsdk:"state"`
+	// NewerThan filters the reports to those newer than the given number of days.
+	NewerThan types.Int64 `tfsdk:"newer_than"`
+	// Sort is the field used to sort the reports.
+	Sort types.String `tfsdk:"sort"`
+	// Dir is the direction of the sort.
+	Dir types.String `tfsdk:"dir"`
+	// Limit is the maximum number of reports returned.
+	Limit types.Int64 `tfsdk:"limit"`
+}
+
+// SyncIQReportModel defines the model of a SyncIQ report.
+type SyncIQReportModel struct {
+	// ID is the unique identifier of the report.
+	ID types.String `tfsdk:"id"`
+	// JobID is the ID of the job.
+	JobID types.Int64 `tfsdk:"job_id"`
+	// PolicyID is the ID of the policy.
+	PolicyID types.String `tfsdk:"policy_id"`
+	// PolicyName is the name of the policy.
+	PolicyName types.String `tfsdk:"policy_name"`
+	// Action is the action performed by the job.
+	Action types.String `tfsdk:"action"`
+	// State is the state of the job.
+	State types.String `tfsdk:"state"`
+	// SyncType is the type of the sync.
+	SyncType types.String `tfsdk:"sync_type"`
+	// StartTime is the time the job started.
+	StartTime types.Int64 `tfsdk:"start_time"`
+	// EndTime is the time the job ended.
+	EndTime types.Int64 `tfsdk:"end_time"`
+	// Duration is the duration of the job in seconds.
+	Duration types.Int64 `tfsdk:"duration"`
+	// TotalFiles is the number of files affected by the job.
+	TotalFiles types.Int64 `tfsdk:"total_files"`
+	// FilesTransferred is the number of files transferred by the job.
+	FilesTransferred types.Int64 `tfsdk:"files_transferred"`
+	// BytesTransferred is the number of bytes transferred by the job.
+	BytesTransferred types.Int64 `tfsdk:"bytes_transferred"`
+	// TotalNetworkBytes is the number of bytes sent over the network.
+	TotalNetworkBytes types.Int64 `tfsdk:"total_network_bytes"`
+	// Errors is a list of error messages of the job.
+	Errors types.List `tfsdk:"errors"`
+	// Warnings is a list of warning messages of the job.
+	Warnings types.List `tfsdk:"warnings"`
+}
@@ -66,3 +66,19 @@ type Schedule struct {
 	// Thursday indicates if the rule is in effect on Thursday.
 	Thursday types.Bool `tfsdk:"thursday"`
 }
+
+// SyncIQRuleResource defines the model of a SyncIQ rule resource.
+type SyncIQRuleResource struct {
+	// ID is the unique identifier of the rule.
+	ID types.String `tfsdk:"id"`
+	// Type is the type of system resource this rule limits.
+	Type types.String `tfsdk:"type"`
+	// Description is the description of the rule.
+	Description types.String `tfsdk:"description"`
+	// Enabled indicates if the rule is enabled.
+	Enabled types.Bool `tfsdk:"enabled"`
+	// Limit is the limit of the rule.
+	Limit types.Int64 `tfsdk:"limit"`
+	// Schedule is the schedule of the rule.
+	Schedule types.Object `tfsdk:"schedule"`
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SyncIQTargetPolicyDataSource defines the SyncIQ target policy data source implementation.
+type SyncIQTargetPolicyDataSource struct {
+	// ID is the unique identifier of the data source.
+	ID types.String `tfsdk:"id"`
+	// Policies is a list of SyncIQ target policies.
+	Policies []SyncIQTargetPolicyModel `tfsdk:"target_policies"`
+	// Filter is the filter of the data source.
+	Filter *SyncIQTargetPolicyFilterType `tfsdk:"filter"`
+}
+
+// SyncIQTargetPolicyFilterType describes the filter data model.
+type SyncIQTargetPolicyFilterType struct {
+	// Names filters the target policies by name.
+	Names []types.String `tfsdk:"names"`
+	// TargetPath filters the target policies by target path.
+	TargetPath types.String `tfsdk:"target_path"`
+	// LastJobState filters the target policies by the state of the last job.
+	LastJobState types.String `tfsdk:"last_job_state"`
+	// FailoverFailbackState filters the target policies by the failover/failback state.
+	FailoverFailbackState types.String `tfsdk:"failover_failback_state"`
+}
+
+// SyncIQTargetPolicyModel defines the model of a SyncIQ target policy.
+type SyncIQTargetPolicyModel struct {
+	// ID is the unique identifier of the target policy.
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the policy.
+	Name types.String `tfsdk:"name"`
+	// SourceClusterGUID is the GUID of the source cluster.
+	SourceClusterGUID types.String `tfsdk:"source_cluster_guid"`
+	// SourceHost is the source cluster host name or IP address.
+	SourceHost types.String `tfsdk:"source_host"`
+	// TargetPath is the target directory of the policy.
+	TargetPath types.String `tfsdk:"target_path"`
+	// FailoverFailbackState is the state of the failover/failback of the policy.
+	FailoverFailbackState types.String `tfsdk:"failover_failback_state"`
+	// LastJobState is the state of the last job of the policy.
+	LastJobState types.String `tfsdk:"last_job_state"`
+	// LastSourceCoordinatorIP is the IP address of the last source coordinator.
+	LastSourceCoordinatorIP types.String `tfsdk:"last_source_coordinator_ip"`
+	// LastUpdateFromSource is the time of the last update from the source.
+	LastUpdateFromSource types.Int64 `tfsdk:"last_update_from_source"`
+	// LegacyPolicy indicates if the policy is a legacy policy.
+	LegacyPolicy types.Bool `tfsdk:"legacy_policy"`
+	// TargetSnapshotAlias is the alias of the target snapshot.
+	TargetSnapshotAlias types.String `tfsdk:"target_snapshot_alias"`
+	// TargetSnapshotArchive indicates if target snapshots are archived.
+	TargetSnapshotArchive types.Bool `tfsdk:"target_snapshot_archive"`
+	// TargetSnapshotExpiration is the expiration of the target snapshots in seconds.
+	TargetSnapshotExpiration types.Int64 `tfsdk:"target_snapshot_expiration"`
+	// TargetSnapshotPattern is the naming pattern of the target snapshots.
+	TargetSnapshotPattern types.String `tfsdk:"target_snapshot_pattern"`
+}
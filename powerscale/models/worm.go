@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// WormDomainResourceModel describes the WORM domain resource data model.
+type WormDomainResourceModel struct {
+	// The system ID given to the domain.
+	ID types.String `tfsdk:"id"`
+	// The root path of the domain.
+	Path types.String `tfsdk:"path"`
+	// The type of the domain: enterprise or compliance.
+	Type types.String `tfsdk:"type"`
+	// The autocommit time period in seconds, after which a file is committed to the WORM state.
+	AutocommitOffset types.Int64 `tfsdk:"autocommit_offset"`
+	// The retention given to a committed file when none is set on it.
+	DefaultRetention types.String `tfsdk:"default_retention"`
+	// The minimum retention of the files of the domain.
+	MinRetention types.String `tfsdk:"min_retention"`
+	// The maximum retention of the files of the domain.
+	MaxRetention types.String `tfsdk:"max_retention"`
+	// The override retention date of the domain, in unix epoch seconds.
+	OverrideDate types.Int64 `tfsdk:"override_date"`
+	// The privileged delete state of the domain: on, off or disabled.
+	PrivilegedDelete types.String `tfsdk:"privileged_delete"`
+	// The LIN of the root directory of the domain.
+	Lin types.Int64 `tfsdk:"lin"`
+}
+
+// WormSettingsResourceModel describes the WORM settings resource data model.
+type WormSettingsResourceModel struct {
+	// The ID of the settings, always worm_settings.
+	ID types.String `tfsdk:"id"`
+	// Whether the compliance clock is set.
+	SetComplianceClock types.Bool `tfsdk:"set_compliance_clock"`
+	// The current time of the compliance clock, in unix epoch seconds.
+	ComplianceClockTime types.Int64 `tfsdk:"compliance_clock_time"`
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAdsProviderDomainControllerDataSource tests the ADS provider domain controller data source.
+func TestAccAdsProviderDomainControllerDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read
+			{
+				Config: ProviderConfig + testAccAdsProviderDomainControllerDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_adsprovider_domain_controller.test", "id", "adsprovider_domain_controller_datasource"),
+					resource.TestCheckResourceAttr("data.powerscale_adsprovider_domain_controller.test", "ads_provider_id", adsName),
+					resource.TestCheckResourceAttrSet("data.powerscale_adsprovider_domain_controller.test", "domain_controllers.#"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccAdsProviderDomainControllerDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAdsProviderDomainControllers).Return(nil, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// mapping error
+			{
+				Config: ProviderConfig + testAccAdsProviderDomainControllerDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.NewAdsProviderDomainControllerDataSource).Return(nil, fmt.Errorf("mock mapping error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock mapping error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccAdsProviderDomainControllerDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccAdsProviderDomainControllerDataSourceConfig = AdsProviderResourceConfig + `
+data "powerscale_adsprovider_domain_controller" "test" {
+	ads_provider_id = powerscale_adsprovider.ads_test.id
+}
+`
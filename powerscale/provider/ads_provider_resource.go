@@ -29,7 +29,9 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -37,6 +39,7 @@ import (
 var _ resource.Resource = &AdsProviderResource{}
 var _ resource.ResourceWithConfigure = &AdsProviderResource{}
 var _ resource.ResourceWithImportState = &AdsProviderResource{}
+var _ resource.ResourceWithModifyPlan = &AdsProviderResource{}
 
 // NewAdsProviderResource creates a new resource.
 func NewAdsProviderResource() resource.Resource {
@@ -410,6 +413,20 @@ func (r *AdsProviderResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"spns_reconcile_mode": schema.StringAttribute{
+				Description:         "Specifies how the SPNs are reconciled on every apply. 'check' reports the missing SPNs as warnings. 'fix' adds the missing SPNs to the provider, and cannot be used with spns.",
+				MarkdownDescription: "Specifies how the SPNs are reconciled on every apply. 'check' reports the missing SPNs as warnings. 'fix' adds the missing SPNs to the provider, and cannot be used with spns.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.AdsProviderSpnsCheckMode, helper.AdsProviderSpnsFixMode),
+				},
+			},
+			"missing_spns": schema.ListAttribute{
+				Description:         "Recommended and extra expected SPNs which are not configured.",
+				MarkdownDescription: "Recommended and extra expected SPNs which are not configured.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
@@ -500,8 +517,17 @@ func (r *AdsProviderResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	createdAds := getAdsResponse.Ads[0]
-	err = helper.CopyFieldsToNonNestedModel(ctx, createdAds, &plan)
+	createdAds, err := helper.ReconcileAdsProviderSpns(ctx, r.client, plan, &getAdsResponse.Ads[0])
+	if err != nil {
+		errStr := constants.UpdateAdsProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error fixing the SPNs of ads provider",
+			message,
+		)
+		return
+	}
+	err = helper.CopyFieldsToNonNestedModel(ctx, *createdAds, &plan)
 	if err != nil {
 		errStr := constants.ReadAdsProviderErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -511,6 +537,7 @@ func (r *AdsProviderResource) Create(ctx context.Context, req resource.CreateReq
 		)
 		return
 	}
+	resp.Diagnostics.Append(helper.SetAdsProviderMissingSpns(ctx, &plan, createdAds)...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -567,6 +594,7 @@ func (r *AdsProviderResource) Read(ctx context.Context, req resource.ReadRequest
 		)
 		return
 	}
+	resp.Diagnostics.Append(helper.SetAdsProviderMissingSpns(ctx, &adsState, &adsResponse.Ads[0])...)
 
 	diags = resp.State.Set(ctx, adsState)
 	resp.Diagnostics.Append(diags...)
@@ -661,7 +689,17 @@ func (r *AdsProviderResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	err = helper.CopyFieldsToNonNestedModel(ctx, updatedAds.Ads[0], &adsPlan)
+	reconciledAds, err := helper.ReconcileAdsProviderSpns(ctx, r.client, adsPlan, &updatedAds.Ads[0])
+	if err != nil {
+		errStr := constants.UpdateAdsProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error fixing the SPNs of ads provider",
+			message,
+		)
+		return
+	}
+	err = helper.CopyFieldsToNonNestedModel(ctx, *reconciledAds, &adsPlan)
 	if err != nil {
 		errStr := constants.ReadAdsProviderErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -671,6 +709,7 @@ func (r *AdsProviderResource) Update(ctx context.Context, req resource.UpdateReq
 		)
 		return
 	}
+	resp.Diagnostics.Append(helper.SetAdsProviderMissingSpns(ctx, &adsPlan, reconciledAds)...)
 	diags = resp.State.Set(ctx, adsPlan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -679,6 +718,40 @@ func (r *AdsProviderResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Info(ctx, "update ads provider completed")
 }
 
+// ModifyPlan plans an update to fix the SPNs on every apply when SPNs are missing in fix mode.
+func (r *AdsProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var adsPlan models.AdsProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &adsPlan)...)
+	if resp.Diagnostics.HasError() || adsPlan.SpnsReconcileMode.ValueString() != helper.AdsProviderSpnsFixMode {
+		return
+	}
+
+	var configSpns types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("spns"), &configSpns)...)
+	if !configSpns.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("spns"),
+			"Invalid Attribute Combination",
+			"spns should not be provided when spns_reconcile_mode is fix, the missing SPNs are added to the configured SPNs",
+		)
+		return
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var adsState models.AdsProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &adsState)...)
+	if resp.Diagnostics.HasError() || len(adsState.MissingSpns.Elements()) == 0 {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("spns"), types.ListUnknown(types.StringType))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("missing_spns"), types.ListUnknown(types.StringType))...)
+}
+
 // Delete deletes the resource.
 func (r *AdsProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "deleting ads provider")
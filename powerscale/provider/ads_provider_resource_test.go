@@ -237,6 +237,47 @@ func TestAccAdsProviderResourceErrorReadState(t *testing.T) {
 	})
 }
 
+func TestAccAdsProviderResourceSpns(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with SPNs check
+			{
+				Config: ProviderConfig + AdsProviderSpnsCheckResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_adsprovider.ads_test", "spns_reconcile_mode", "check"),
+					resource.TestCheckResourceAttrSet("powerscale_adsprovider.ads_test", "missing_spns.#"),
+				),
+			},
+			// Fix SPNs with configured SPNs
+			{
+				Config:      ProviderConfig + AdsProviderSpnsFixInvalidResourceConfig,
+				ExpectError: regexp.MustCompile(".*Invalid Attribute Combination*."),
+			},
+			// Fix SPNs error
+			{
+				Config: ProviderConfig + AdsProviderSpnsFixResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ReconcileAdsProviderSpns).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Fix SPNs
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + AdsProviderSpnsFixResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_adsprovider.ads_test", "spns_reconcile_mode", "fix"),
+					resource.TestCheckResourceAttr("powerscale_adsprovider.ads_test", "missing_spns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 var adsName = "PIE.LAB.EMC.COM"
 
 var AdsProviderResourceConfig = fmt.Sprintf(`
@@ -301,3 +342,31 @@ resource "powerscale_adsprovider" "ads_test" {
 	groupnet = "groupnet_x"
 }
 `, adsName)
+
+var AdsProviderSpnsCheckResourceConfig = fmt.Sprintf(`
+resource "powerscale_adsprovider" "ads_test" {
+	name = "%s"
+	user = "administrator"
+	password = "Password123!"
+	spns_reconcile_mode = "check"
+}
+`, adsName)
+
+var AdsProviderSpnsFixResourceConfig = fmt.Sprintf(`
+resource "powerscale_adsprovider" "ads_test" {
+	name = "%s"
+	user = "administrator"
+	password = "Password123!"
+	spns_reconcile_mode = "fix"
+}
+`, adsName)
+
+var AdsProviderSpnsFixInvalidResourceConfig = fmt.Sprintf(`
+resource "powerscale_adsprovider" "ads_test" {
+	name = "%s"
+	user = "administrator"
+	password = "Password123!"
+	spns_reconcile_mode = "fix"
+	spns = ["HOST/tfacc"]
+}
+`, adsName)
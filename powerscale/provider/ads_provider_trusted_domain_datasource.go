@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AdsProviderTrustedDomainDataSource{}
+	_ datasource.DataSourceWithConfigure = &AdsProviderTrustedDomainDataSource{}
+)
+
+// NewAdsProviderTrustedDomainDataSource creates a new data source.
+func NewAdsProviderTrustedDomainDataSource() datasource.DataSource {
+	return &AdsProviderTrustedDomainDataSource{}
+}
+
+// AdsProviderTrustedDomainDataSource defines the data source implementation.
+type AdsProviderTrustedDomainDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *AdsProviderTrustedDomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_adsprovider_trusted_domain"
+}
+
+// Schema describes the data source arguments.
+func (d *AdsProviderTrustedDomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the trusted domains of an ADS provider from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the trusted domains of an ADS provider from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"ads_provider_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the ADS provider.",
+				MarkdownDescription: "The ID of the ADS provider.",
+			},
+			"trusted_domains": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of trusted domains.",
+				MarkdownDescription: "List of trusted domains.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"client_site_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the site where the Active Directory client is located.",
+							MarkdownDescription: "Specifies the site where the Active Directory client is located.",
+						},
+						"dc_address": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the address of the domain controller.",
+							MarkdownDescription: "Specifies the address of the domain controller.",
+						},
+						"dc_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the name of the domain controller.",
+							MarkdownDescription: "Specifies the name of the domain controller.",
+						},
+						"dc_site_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the site where the domain controller is located.",
+							MarkdownDescription: "Specifies the site where the domain controller is located.",
+						},
+						"dns_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the DNS name of the domain.",
+							MarkdownDescription: "Specifies the DNS name of the domain.",
+						},
+						"forest_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the name of the Active Directory forest of the domain.",
+							MarkdownDescription: "Specifies the name of the Active Directory forest of the domain.",
+						},
+						"netbios_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the NetBIOS name of the domain.",
+							MarkdownDescription: "Specifies the NetBIOS name of the domain.",
+						},
+						"sid": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the security identifier of the domain.",
+							MarkdownDescription: "Specifies the security identifier of the domain.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the status of the domain.",
+							MarkdownDescription: "Specifies the status of the domain.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *AdsProviderTrustedDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *AdsProviderTrustedDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.AdsProviderTrustedDomainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adsID := data.AdsProviderID.ValueString()
+	items, err := helper.GetAdsProviderTrustedDomains(ctx, d.client, adsID)
+	if err != nil {
+		errStr := constants.ReadAdsProviderTrustedDomainsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading trusted domains", message)
+		return
+	}
+
+	state, err := helper.NewAdsProviderTrustedDomainDataSource(ctx, adsID, items)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map trusted domain fields", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAdsProviderTrustedDomainDataSource tests the ADS provider trusted domain data source.
+func TestAccAdsProviderTrustedDomainDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read
+			{
+				Config: ProviderConfig + testAccAdsProviderTrustedDomainDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_adsprovider_trusted_domain.test", "id", "adsprovider_trusted_domain_datasource"),
+					resource.TestCheckResourceAttr("data.powerscale_adsprovider_trusted_domain.test", "ads_provider_id", adsName),
+					resource.TestCheckResourceAttrSet("data.powerscale_adsprovider_trusted_domain.test", "trusted_domains.#"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccAdsProviderTrustedDomainDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAdsProviderTrustedDomains).Return(nil, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// mapping error
+			{
+				Config: ProviderConfig + testAccAdsProviderTrustedDomainDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.NewAdsProviderTrustedDomainDataSource).Return(nil, fmt.Errorf("mock mapping error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock mapping error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccAdsProviderTrustedDomainDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccAdsProviderTrustedDomainDataSourceConfig = AdsProviderResourceConfig + `
+data "powerscale_adsprovider_trusted_domain" "test" {
+	ads_provider_id = powerscale_adsprovider.ads_test.id
+}
+`
@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuthGlobalSettingsDataSource{}
+
+// NewAuthGlobalSettingsDataSource creates a new data source.
+func NewAuthGlobalSettingsDataSource() datasource.DataSource {
+	return &AuthGlobalSettingsDataSource{}
+}
+
+// AuthGlobalSettingsDataSource defines the data source implementation.
+type AuthGlobalSettingsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *AuthGlobalSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_global_settings"
+}
+
+// Schema describes the data source arguments.
+func (d *AuthGlobalSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the Auth Global Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block. Auth Global Settings control identity allocation, on-disk identity, NTLM and RPC behaviour of the authentication service.",
+		Description:         "This datasource is used to query the Auth Global Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block. Auth Global Settings control identity allocation, on-disk identity, NTLM and RPC behaviour of the authentication service.",
+		Attributes: map[string]schema.Attribute{
+			"alloc_retries": schema.Int64Attribute{
+				Description:         "Specifies the number of times to retry an ID allocation before failing.",
+				MarkdownDescription: "Specifies the number of times to retry an ID allocation before failing.",
+				Computed:            true,
+			},
+			"cache_cred_lifetime": schema.Int64Attribute{
+				Description:         "Specifies the length of time in seconds to cache credential responses from the ID mapper.",
+				MarkdownDescription: "Specifies the length of time in seconds to cache credential responses from the ID mapper.",
+				Computed:            true,
+			},
+			"cache_id_lifetime": schema.Int64Attribute{
+				Description:         "Specifies the length of time in seconds to cache ID responses from the ID mapper.",
+				MarkdownDescription: "Specifies the length of time in seconds to cache ID responses from the ID mapper.",
+				Computed:            true,
+			},
+			"failed_login_delay_time": schema.Int64Attribute{
+				Description:         "Specifies the time in seconds to delay a failed login.",
+				MarkdownDescription: "Specifies the time in seconds to delay a failed login.",
+				Computed:            true,
+			},
+			"on_disk_identity": schema.StringAttribute{
+				Description:         "Specifies the type of identity that is stored on disk. Options: native, unix, sid",
+				MarkdownDescription: "Specifies the type of identity that is stored on disk. Options: native, unix, sid",
+				Computed:            true,
+			},
+			"rpc_block_time": schema.Int64Attribute{
+				Description:         "Specifies the minimum amount of time in milliseconds to wait before performing an oprestart.",
+				MarkdownDescription: "Specifies the minimum amount of time in milliseconds to wait before performing an oprestart.",
+				Computed:            true,
+			},
+			"rpc_max_requests": schema.Int64Attribute{
+				Description:         "Specifies the maximum number of outstanding RPC requests.",
+				MarkdownDescription: "Specifies the maximum number of outstanding RPC requests.",
+				Computed:            true,
+			},
+			"rpc_timeout": schema.Int64Attribute{
+				Description:         "Specifies the maximum amount of time in seconds to wait for an idmap response.",
+				MarkdownDescription: "Specifies the maximum amount of time in seconds to wait for an idmap response.",
+				Computed:            true,
+			},
+			"send_ntlmv2": schema.BoolAttribute{
+				Description:         "Specifies whether to send NTLMv2 responses.",
+				MarkdownDescription: "Specifies whether to send NTLMv2 responses.",
+				Computed:            true,
+			},
+			"space_replacement": schema.StringAttribute{
+				Description:         "Specifies the space replacement character.",
+				MarkdownDescription: "Specifies the space replacement character.",
+				Computed:            true,
+			},
+			"unknown_gid": schema.Int64Attribute{
+				Description:         "Specifies the GID to use for the unknown (anonymous) group.",
+				MarkdownDescription: "Specifies the GID to use for the unknown (anonymous) group.",
+				Computed:            true,
+			},
+			"unknown_uid": schema.Int64Attribute{
+				Description:         "Specifies the UID to use for the unknown (anonymous) user.",
+				MarkdownDescription: "Specifies the UID to use for the unknown (anonymous) user.",
+				Computed:            true,
+			},
+			"workgroup": schema.StringAttribute{
+				Description:         "Specifies the NetBIOS workgroup or domain.",
+				MarkdownDescription: "Specifies the NetBIOS workgroup or domain.",
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Specifies the access zone in which the global settings are applied. If not set, the settings of the System zone are used.",
+				MarkdownDescription: "Specifies the access zone in which the global settings are applied. If not set, the settings of the System zone are used.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *AuthGlobalSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *AuthGlobalSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading auth global settings data source")
+
+	var state models.AuthGlobalSettingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authGlobalSettingsResp, err := helper.GetAuthGlobalSettings(ctx, d.client, state.Zone.ValueString())
+
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error getting the auth global settings",
+			message,
+		)
+		return
+	}
+
+	authGlobalSettings, err := helper.AuthGlobalSettingsDetailMapper(ctx, authGlobalSettingsResp.GlobalSettings)
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error mapping the list of auth global settings",
+			message,
+		)
+		return
+	}
+
+	authGlobalSettings.Zone = state.Zone
+	state = authGlobalSettings
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading auth global settings data source")
+}
@@ -0,0 +1,417 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthGlobalSettingsResource{}
+var _ resource.ResourceWithConfigure = &AuthGlobalSettingsResource{}
+var _ resource.ResourceWithImportState = &AuthGlobalSettingsResource{}
+
+// NewAuthGlobalSettingsResource creates a new resource.
+func NewAuthGlobalSettingsResource() resource.Resource {
+	return &AuthGlobalSettingsResource{}
+}
+
+// AuthGlobalSettingsResource defines the resource implementation.
+type AuthGlobalSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *AuthGlobalSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_global_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *AuthGlobalSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the Auth Global Settings entity of PowerScale Array. We can Create, Update and Delete the Auth Global Settings using this resource. " +
+			"We can also import the existing Auth Global Settings from PowerScale array. Note that, Auth Global Settings is the native functionality of PowerScale. When creating the resource, we actually load Auth Global Settings from PowerScale to the resource state.",
+		Description: "This resource is used to manage the Auth Global Settings entity of PowerScale Array. We can Create, Update and Delete the Auth Global Settings using this resource. " +
+			"We can also import the existing Auth Global Settings from PowerScale array. Note that, Auth Global Settings is the native functionality of PowerScale. When creating the resource, we actually load Auth Global Settings from PowerScale to the resource state.",
+		Attributes: map[string]schema.Attribute{
+			"alloc_retries": schema.Int64Attribute{
+				Description:         "Specifies the number of times to retry an ID allocation before failing.",
+				MarkdownDescription: "Specifies the number of times to retry an ID allocation before failing.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cache_cred_lifetime": schema.Int64Attribute{
+				Description:         "Specifies the length of time in seconds to cache credential responses from the ID mapper.",
+				MarkdownDescription: "Specifies the length of time in seconds to cache credential responses from the ID mapper.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cache_id_lifetime": schema.Int64Attribute{
+				Description:         "Specifies the length of time in seconds to cache ID responses from the ID mapper.",
+				MarkdownDescription: "Specifies the length of time in seconds to cache ID responses from the ID mapper.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"failed_login_delay_time": schema.Int64Attribute{
+				Description:         "Specifies the time in seconds to delay a failed login.",
+				MarkdownDescription: "Specifies the time in seconds to delay a failed login.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_disk_identity": schema.StringAttribute{
+				Description:         "Specifies the type of identity that is stored on disk. Options: native, unix, sid",
+				MarkdownDescription: "Specifies the type of identity that is stored on disk. Options: native, unix, sid",
+				Optional:            true,
+				Computed:            true,
+			},
+			"rpc_block_time": schema.Int64Attribute{
+				Description:         "Specifies the minimum amount of time in milliseconds to wait before performing an oprestart.",
+				MarkdownDescription: "Specifies the minimum amount of time in milliseconds to wait before performing an oprestart.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"rpc_max_requests": schema.Int64Attribute{
+				Description:         "Specifies the maximum number of outstanding RPC requests.",
+				MarkdownDescription: "Specifies the maximum number of outstanding RPC requests.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"rpc_timeout": schema.Int64Attribute{
+				Description:         "Specifies the maximum amount of time in seconds to wait for an idmap response.",
+				MarkdownDescription: "Specifies the maximum amount of time in seconds to wait for an idmap response.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"send_ntlmv2": schema.BoolAttribute{
+				Description:         "Specifies whether to send NTLMv2 responses.",
+				MarkdownDescription: "Specifies whether to send NTLMv2 responses.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"space_replacement": schema.StringAttribute{
+				Description:         "Specifies the space replacement character.",
+				MarkdownDescription: "Specifies the space replacement character.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"unknown_gid": schema.Int64Attribute{
+				Description:         "Specifies the GID to use for the unknown (anonymous) group.",
+				MarkdownDescription: "Specifies the GID to use for the unknown (anonymous) group.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"unknown_uid": schema.Int64Attribute{
+				Description:         "Specifies the UID to use for the unknown (anonymous) user.",
+				MarkdownDescription: "Specifies the UID to use for the unknown (anonymous) user.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"workgroup": schema.StringAttribute{
+				Description:         "Specifies the NetBIOS workgroup or domain.",
+				MarkdownDescription: "Specifies the NetBIOS workgroup or domain.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Specifies the access zone in which the global settings are applied. If not set, the settings of the System zone are used. Cannot be changed once set.",
+				MarkdownDescription: "Specifies the access zone in which the global settings are applied. If not set, the settings of the System zone are used. Cannot be changed once set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AuthGlobalSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	powerscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = powerscaleClient
+}
+
+// Create allocates the resource.
+func (r *AuthGlobalSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating auth global settings")
+
+	var plan models.AuthGlobalSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authGlobalSettingsToCreate := powerscale.V7SettingsGlobalGlobalSettings{}
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, plan, &authGlobalSettingsToCreate)
+	if err != nil {
+		errStr := constants.CreateAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth global settings",
+			fmt.Sprintf("Could not read auth global settings param with error: %s", message),
+		)
+		return
+	}
+	err = helper.UpdateAuthGlobalSettings(ctx, r.client, plan.Zone.ValueString(), authGlobalSettingsToCreate)
+	if err != nil {
+		errStr := constants.CreateAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth global settings",
+			message,
+		)
+		return
+	}
+	tflog.Debug(ctx, "auth global settings initialized")
+
+	getAuthGlobalSettingsResponse, err := helper.GetAuthGlobalSettings(ctx, r.client, plan.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth global settings",
+			message,
+		)
+		return
+	}
+
+	createdAuthGlobalSettings := getAuthGlobalSettingsResponse.GlobalSettings
+	err = helper.CopyFields(ctx, createdAuthGlobalSettings, &plan)
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth global settings",
+			fmt.Sprintf("Could not read auth global settings struct with error: %s", message),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "create auth global settings completed")
+}
+
+// Read reads the resource state.
+func (r *AuthGlobalSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading auth global settings")
+
+	var authGlobalSettingsState models.AuthGlobalSettingsResourceModel
+	diags := req.State.Get(ctx, &authGlobalSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "calling get auth global settings")
+	authGlobalSettingsResponse, err := helper.GetAuthGlobalSettings(ctx, r.client, authGlobalSettingsState.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading auth global settings",
+			message,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "updating read auth global settings state", map[string]interface{}{
+		"authGlobalSettingsResponse": authGlobalSettingsResponse,
+		"authGlobalSettingsState":    authGlobalSettingsState,
+	})
+	err = helper.CopyFields(ctx, authGlobalSettingsResponse.GlobalSettings, &authGlobalSettingsState)
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading auth global settings",
+			fmt.Sprintf("Could not read auth global settings struct with error: %s", message),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, authGlobalSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read auth global settings completed")
+}
+
+// Update updates the resource state.
+func (r *AuthGlobalSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating auth global settings")
+
+	var authGlobalSettingsPlan models.AuthGlobalSettingsResourceModel
+	diags := req.Plan.Get(ctx, &authGlobalSettingsPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var authGlobalSettingsState models.AuthGlobalSettingsResourceModel
+	diags = resp.State.Get(ctx, &authGlobalSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "calling update auth global settings", map[string]interface{}{
+		"authGlobalSettingsPlan":  authGlobalSettingsPlan,
+		"authGlobalSettingsState": authGlobalSettingsState,
+	})
+
+	var authGlobalSettingsToUpdate powerscale.V7SettingsGlobalGlobalSettings
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, authGlobalSettingsPlan, &authGlobalSettingsToUpdate)
+	if err != nil {
+		errStr := constants.UpdateAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth global settings",
+			fmt.Sprintf("Could not read auth global settings param with error: %s", message),
+		)
+		return
+	}
+	err = helper.UpdateAuthGlobalSettings(ctx, r.client, authGlobalSettingsPlan.Zone.ValueString(), authGlobalSettingsToUpdate)
+	if err != nil {
+		errStr := constants.UpdateAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth global settings",
+			message,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "calling get auth global settings on powerscale client")
+	updatedAuthGlobalSettings, err := helper.GetAuthGlobalSettings(ctx, r.client, authGlobalSettingsPlan.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth global settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFields(ctx, updatedAuthGlobalSettings.GlobalSettings, &authGlobalSettingsPlan)
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth global settings",
+			fmt.Sprintf("Could not read auth global settings struct with error: %s", message),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, authGlobalSettingsPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "update auth global settings completed")
+}
+
+// Delete deletes the resource.
+func (r *AuthGlobalSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting auth global settings")
+
+	var authGlobalSettingsState models.AuthGlobalSettingsResourceModel
+	diags := req.State.Get(ctx, &authGlobalSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "delete auth global settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *AuthGlobalSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var authGlobalSettingsState models.AuthGlobalSettingsResourceModel
+	if req.ID != "" {
+		authGlobalSettingsState.Zone = types.StringValue(req.ID)
+	}
+
+	tflog.Debug(ctx, "calling get auth global settings")
+	authGlobalSettingsResponse, err := helper.GetAuthGlobalSettings(ctx, r.client, authGlobalSettingsState.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error importing auth global settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFields(ctx, authGlobalSettingsResponse.GlobalSettings, &authGlobalSettingsState)
+	if err != nil {
+		errStr := constants.ReadAuthGlobalSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error importing auth global settings",
+			fmt.Sprintf("Could not read auth global settings struct with error: %s", message),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, authGlobalSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "import auth global settings completed")
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+)
+
+func TestAccAuthGlobalSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthGlobalSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "workgroup", authGlobalWorkgroup),
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "send_ntlmv2", authGlobalSendNtlmv2),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  "powerscale_auth_global_settings.test",
+				ImportState:   true,
+				ImportStateId: "System",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, authGlobalWorkgroup, states[0].Attributes["workgroup"])
+					assert.Equal(t, authGlobalSendNtlmv2, states[0].Attributes["send_ntlmv2"])
+					assert.Equal(t, "System", states[0].Attributes["zone"])
+					return nil
+				},
+			},
+			// Update
+			{
+				Config: ProviderConfig + AuthGlobalSettingsUpdatedResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "workgroup", "WORKGROUP2"),
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "send_ntlmv2", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthGlobalSettingsResourceErrorRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthGlobalSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "workgroup", authGlobalWorkgroup),
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "send_ntlmv2", authGlobalSendNtlmv2),
+				),
+			},
+			// ImportState testing get error
+			{
+				ResourceName: "powerscale_auth_global_settings.test",
+				ImportState:  true,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetAuthGlobalSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthGlobalSettingsResourceErrorUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthGlobalSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "workgroup", authGlobalWorkgroup),
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "send_ntlmv2", authGlobalSendNtlmv2),
+				),
+			},
+			// Update param read error
+			{
+				Config: ProviderConfig + AuthGlobalSettingsUpdatedResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Update get error
+			{
+				Config: ProviderConfig + AuthGlobalSettingsUpdatedResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.UpdateAuthGlobalSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Update get error
+			{
+				Config: ProviderConfig + AuthGlobalSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetAuthGlobalSettings).Return(nil, fmt.Errorf("mock error")).Build().
+						When(func(ctx context.Context, client *client.Client, zone string) bool {
+							return FunctionMocker.Times() == 2
+						})
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthGlobalSettingsResourceErrorCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + AuthGlobalSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateAuthGlobalSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthGlobalSettingsResourceErrorCopyField(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthGlobalSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "workgroup", authGlobalWorkgroup),
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "send_ntlmv2", authGlobalSendNtlmv2),
+				),
+			},
+			{
+				ResourceName: "powerscale_auth_global_settings.test",
+				ImportState:  true,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CopyFields).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthGlobalSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.CopyFields).Return(fmt.Errorf("mock error")).Build().
+						When(func(ctx context.Context, source, destination interface{}) bool {
+							return FunctionMocker.Times() == 2
+						})
+				},
+				Config:      ProviderConfig + AuthGlobalSettingsUpdatedResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthGlobalSettingsResourceErrorReadState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthGlobalSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Change the settings back to default
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + AuthGlobalSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "workgroup", authGlobalWorkgroup),
+					resource.TestCheckResourceAttr("powerscale_auth_global_settings.test", "send_ntlmv2", authGlobalSendNtlmv2),
+				),
+			},
+		},
+	})
+}
+
+var authGlobalWorkgroup = "WORKGROUP"
+var authGlobalSendNtlmv2 = "false"
+
+var AuthGlobalSettingsResourceConfig = fmt.Sprintf(`
+resource "powerscale_auth_global_settings" "test" {
+	workgroup = "%s"
+	send_ntlmv2 = %s
+}
+`, authGlobalWorkgroup, authGlobalSendNtlmv2)
+
+var AuthGlobalSettingsUpdatedResourceConfig = `
+resource "powerscale_auth_global_settings" "test" {
+	workgroup = "WORKGROUP2"
+	send_ntlmv2 = true
+}
+`
@@ -0,0 +1,170 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuthMappingSettingsDataSource{}
+
+// NewAuthMappingSettingsDataSource creates a new data source.
+func NewAuthMappingSettingsDataSource() datasource.DataSource {
+	return &AuthMappingSettingsDataSource{}
+}
+
+// AuthMappingSettingsDataSource defines the data source implementation.
+type AuthMappingSettingsDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *AuthMappingSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_mapping_settings"
+}
+
+// Schema describes the data source arguments.
+func (d *AuthMappingSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the Auth Mapping Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block. Auth Mapping Settings control the UID and GID ranges used by the ID mapper when allocating identities.",
+		Description:         "This datasource is used to query the Auth Mapping Settings from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block. Auth Mapping Settings control the UID and GID ranges used by the ID mapper when allocating identities.",
+		Attributes: map[string]schema.Attribute{
+			"gid_range_enabled": schema.BoolAttribute{
+				Description:         "If true, allocate GIDs from the specified range.",
+				MarkdownDescription: "If true, allocate GIDs from the specified range.",
+				Computed:            true,
+			},
+			"gid_range_max": schema.Int64Attribute{
+				Description:         "Specifies the ending number for allocating GIDs.",
+				MarkdownDescription: "Specifies the ending number for allocating GIDs.",
+				Computed:            true,
+			},
+			"gid_range_min": schema.Int64Attribute{
+				Description:         "Specifies the starting number for allocating GIDs.",
+				MarkdownDescription: "Specifies the starting number for allocating GIDs.",
+				Computed:            true,
+			},
+			"gid_range_next_gid": schema.Int64Attribute{
+				Description:         "Specifies the next GID to be allocated.",
+				MarkdownDescription: "Specifies the next GID to be allocated.",
+				Computed:            true,
+			},
+			"uid_range_enabled": schema.BoolAttribute{
+				Description:         "If true, allocate UIDs from the specified range.",
+				MarkdownDescription: "If true, allocate UIDs from the specified range.",
+				Computed:            true,
+			},
+			"uid_range_max": schema.Int64Attribute{
+				Description:         "Specifies the ending number for allocating UIDs.",
+				MarkdownDescription: "Specifies the ending number for allocating UIDs.",
+				Computed:            true,
+			},
+			"uid_range_min": schema.Int64Attribute{
+				Description:         "Specifies the starting number for allocating UIDs.",
+				MarkdownDescription: "Specifies the starting number for allocating UIDs.",
+				Computed:            true,
+			},
+			"uid_range_next_uid": schema.Int64Attribute{
+				Description:         "Specifies the next UID to be allocated.",
+				MarkdownDescription: "Specifies the next UID to be allocated.",
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Specifies the access zone in which the mapping settings are applied. If not set, the settings of the System zone are used.",
+				MarkdownDescription: "Specifies the access zone in which the mapping settings are applied. If not set, the settings of the System zone are used.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *AuthMappingSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *AuthMappingSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading auth mapping settings data source")
+
+	var state models.AuthMappingSettingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authMappingSettingsResp, err := helper.GetAuthMappingSettings(ctx, d.client, state.Zone.ValueString())
+
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error getting the auth mapping settings",
+			message,
+		)
+		return
+	}
+
+	authMappingSettings, err := helper.AuthMappingSettingsDetailMapper(ctx, authMappingSettingsResp.Mapping)
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error mapping the list of auth mapping settings",
+			message,
+		)
+		return
+	}
+
+	authMappingSettings.Zone = state.Zone
+	state = authMappingSettings
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading auth mapping settings data source")
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2023-2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuthMappingSettingsDataSourceAll(t *testing.T) {
+	var authMappingSettingsServerTerraformName = "data.powerscale_auth_mapping_settings.all"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read all
+			{
+				Config: ProviderConfig + AuthMappingSettingsAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(authMappingSettingsServerTerraformName, "uid_range_min", authMappingUIDRangeMin),
+					resource.TestCheckResourceAttr(authMappingSettingsServerTerraformName, "gid_range_min", authMappingGIDRangeMin),
+					resource.TestCheckResourceAttr(authMappingSettingsServerTerraformName, "zone", "System"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingSettingsDataSourceGettingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAuthMappingSettings).Return(nil, fmt.Errorf("mock error")).Build().
+						When(func(ctx context.Context, client *client.Client, zone string) bool {
+							return FunctionMocker.Times() == 2
+						})
+				},
+				Config:      ProviderConfig + AuthMappingSettingsAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingSettingsDataSourceMappingErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.AuthMappingSettingsDetailMapper).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingSettingsAllDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var AuthMappingSettingsAllDataSourceConfig = AuthMappingSettingsResourceConfig + `
+data "powerscale_auth_mapping_settings" "all" {
+    zone = "System"
+    depends_on = [
+        powerscale_auth_mapping_settings.test
+    ]
+}
+`
@@ -0,0 +1,387 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthMappingSettingsResource{}
+var _ resource.ResourceWithConfigure = &AuthMappingSettingsResource{}
+var _ resource.ResourceWithImportState = &AuthMappingSettingsResource{}
+
+// NewAuthMappingSettingsResource creates a new resource.
+func NewAuthMappingSettingsResource() resource.Resource {
+	return &AuthMappingSettingsResource{}
+}
+
+// AuthMappingSettingsResource defines the resource implementation.
+type AuthMappingSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *AuthMappingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_mapping_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *AuthMappingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the Auth Mapping Settings entity of PowerScale Array. We can Create, Update and Delete the Auth Mapping Settings using this resource. " +
+			"We can also import the existing Auth Mapping Settings from PowerScale array. Note that, Auth Mapping Settings is the native functionality of PowerScale. When creating the resource, we actually load Auth Mapping Settings from PowerScale to the resource state.",
+		Description: "This resource is used to manage the Auth Mapping Settings entity of PowerScale Array. We can Create, Update and Delete the Auth Mapping Settings using this resource. " +
+			"We can also import the existing Auth Mapping Settings from PowerScale array. Note that, Auth Mapping Settings is the native functionality of PowerScale. When creating the resource, we actually load Auth Mapping Settings from PowerScale to the resource state.",
+		Attributes: map[string]schema.Attribute{
+			"gid_range_enabled": schema.BoolAttribute{
+				Description:         "If true, allocate GIDs from the specified range.",
+				MarkdownDescription: "If true, allocate GIDs from the specified range.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"gid_range_max": schema.Int64Attribute{
+				Description:         "Specifies the ending number for allocating GIDs.",
+				MarkdownDescription: "Specifies the ending number for allocating GIDs.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"gid_range_min": schema.Int64Attribute{
+				Description:         "Specifies the starting number for allocating GIDs.",
+				MarkdownDescription: "Specifies the starting number for allocating GIDs.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"gid_range_next_gid": schema.Int64Attribute{
+				Description:         "Specifies the next GID to be allocated.",
+				MarkdownDescription: "Specifies the next GID to be allocated.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"uid_range_enabled": schema.BoolAttribute{
+				Description:         "If true, allocate UIDs from the specified range.",
+				MarkdownDescription: "If true, allocate UIDs from the specified range.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"uid_range_max": schema.Int64Attribute{
+				Description:         "Specifies the ending number for allocating UIDs.",
+				MarkdownDescription: "Specifies the ending number for allocating UIDs.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"uid_range_min": schema.Int64Attribute{
+				Description:         "Specifies the starting number for allocating UIDs.",
+				MarkdownDescription: "Specifies the starting number for allocating UIDs.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"uid_range_next_uid": schema.Int64Attribute{
+				Description:         "Specifies the next UID to be allocated.",
+				MarkdownDescription: "Specifies the next UID to be allocated.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Specifies the access zone in which the mapping settings are applied. If not set, the settings of the System zone are used. Cannot be changed once set.",
+				MarkdownDescription: "Specifies the access zone in which the mapping settings are applied. If not set, the settings of the System zone are used. Cannot be changed once set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AuthMappingSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	powerscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = powerscaleClient
+}
+
+// Create allocates the resource.
+func (r *AuthMappingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating auth mapping settings")
+
+	var plan models.AuthMappingSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authMappingSettingsToCreate := powerscale.V1SettingsMappingMapping{}
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, plan, &authMappingSettingsToCreate)
+	if err != nil {
+		errStr := constants.CreateAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth mapping settings",
+			fmt.Sprintf("Could not read auth mapping settings param with error: %s", message),
+		)
+		return
+	}
+	err = helper.UpdateAuthMappingSettings(ctx, r.client, plan.Zone.ValueString(), authMappingSettingsToCreate)
+	if err != nil {
+		errStr := constants.CreateAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth mapping settings",
+			message,
+		)
+		return
+	}
+	tflog.Debug(ctx, "auth mapping settings initialized")
+
+	getAuthMappingSettingsResponse, err := helper.GetAuthMappingSettings(ctx, r.client, plan.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth mapping settings",
+			message,
+		)
+		return
+	}
+
+	createdAuthMappingSettings := getAuthMappingSettingsResponse.Mapping
+	err = helper.CopyFields(ctx, createdAuthMappingSettings, &plan)
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating auth mapping settings",
+			fmt.Sprintf("Could not read auth mapping settings struct with error: %s", message),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "create auth mapping settings completed")
+}
+
+// Read reads the resource state.
+func (r *AuthMappingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading auth mapping settings")
+
+	var authMappingSettingsState models.AuthMappingSettingsResourceModel
+	diags := req.State.Get(ctx, &authMappingSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "calling get auth mapping settings")
+	authMappingSettingsResponse, err := helper.GetAuthMappingSettings(ctx, r.client, authMappingSettingsState.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading auth mapping settings",
+			message,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "updating read auth mapping settings state", map[string]interface{}{
+		"authMappingSettingsResponse": authMappingSettingsResponse,
+		"authMappingSettingsState":    authMappingSettingsState,
+	})
+	err = helper.CopyFields(ctx, authMappingSettingsResponse.Mapping, &authMappingSettingsState)
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error reading auth mapping settings",
+			fmt.Sprintf("Could not read auth mapping settings struct with error: %s", message),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, authMappingSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "read auth mapping settings completed")
+}
+
+// Update updates the resource state.
+func (r *AuthMappingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating auth mapping settings")
+
+	var authMappingSettingsPlan models.AuthMappingSettingsResourceModel
+	diags := req.Plan.Get(ctx, &authMappingSettingsPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var authMappingSettingsState models.AuthMappingSettingsResourceModel
+	diags = resp.State.Get(ctx, &authMappingSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "calling update auth mapping settings", map[string]interface{}{
+		"authMappingSettingsPlan":  authMappingSettingsPlan,
+		"authMappingSettingsState": authMappingSettingsState,
+	})
+
+	var authMappingSettingsToUpdate powerscale.V1SettingsMappingMapping
+	// Get param from tf input
+	err := helper.ReadFromState(ctx, authMappingSettingsPlan, &authMappingSettingsToUpdate)
+	if err != nil {
+		errStr := constants.UpdateAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth mapping settings",
+			fmt.Sprintf("Could not read auth mapping settings param with error: %s", message),
+		)
+		return
+	}
+	err = helper.UpdateAuthMappingSettings(ctx, r.client, authMappingSettingsPlan.Zone.ValueString(), authMappingSettingsToUpdate)
+	if err != nil {
+		errStr := constants.UpdateAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth mapping settings",
+			message,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "calling get auth mapping settings on powerscale client")
+	updatedAuthMappingSettings, err := helper.GetAuthMappingSettings(ctx, r.client, authMappingSettingsPlan.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth mapping settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFields(ctx, updatedAuthMappingSettings.Mapping, &authMappingSettingsPlan)
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating auth mapping settings",
+			fmt.Sprintf("Could not read auth mapping settings struct with error: %s", message),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, authMappingSettingsPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "update auth mapping settings completed")
+}
+
+// Delete deletes the resource.
+func (r *AuthMappingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting auth mapping settings")
+
+	var authMappingSettingsState models.AuthMappingSettingsResourceModel
+	diags := req.State.Get(ctx, &authMappingSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "delete auth mapping settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *AuthMappingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var authMappingSettingsState models.AuthMappingSettingsResourceModel
+	if req.ID != "" {
+		authMappingSettingsState.Zone = types.StringValue(req.ID)
+	}
+
+	tflog.Debug(ctx, "calling get auth mapping settings")
+	authMappingSettingsResponse, err := helper.GetAuthMappingSettings(ctx, r.client, authMappingSettingsState.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error importing auth mapping settings",
+			message,
+		)
+		return
+	}
+
+	err = helper.CopyFields(ctx, authMappingSettingsResponse.Mapping, &authMappingSettingsState)
+	if err != nil {
+		errStr := constants.ReadAuthMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error importing auth mapping settings",
+			fmt.Sprintf("Could not read auth mapping settings struct with error: %s", message),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, authMappingSettingsState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "import auth mapping settings completed")
+}
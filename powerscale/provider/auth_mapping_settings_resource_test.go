@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://mozilla.org/MPL/2.0/
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+)
+
+func TestAccAuthMappingSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthMappingSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "uid_range_min", authMappingUIDRangeMin),
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "gid_range_min", authMappingGIDRangeMin),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:  "powerscale_auth_mapping_settings.test",
+				ImportState:   true,
+				ImportStateId: "System",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, authMappingUIDRangeMin, states[0].Attributes["uid_range_min"])
+					assert.Equal(t, authMappingGIDRangeMin, states[0].Attributes["gid_range_min"])
+					assert.Equal(t, "System", states[0].Attributes["zone"])
+					return nil
+				},
+			},
+			// Update
+			{
+				Config: ProviderConfig + AuthMappingSettingsUpdatedResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "uid_range_min", "1100000"),
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "gid_range_min", "1100000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingSettingsResourceErrorRead(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthMappingSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "uid_range_min", authMappingUIDRangeMin),
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "gid_range_min", authMappingGIDRangeMin),
+				),
+			},
+			// ImportState testing get error
+			{
+				ResourceName: "powerscale_auth_mapping_settings.test",
+				ImportState:  true,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.GetAuthMappingSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingSettingsResourceErrorUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthMappingSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "uid_range_min", authMappingUIDRangeMin),
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "gid_range_min", authMappingGIDRangeMin),
+				),
+			},
+			// Update param read error
+			{
+				Config: ProviderConfig + AuthMappingSettingsUpdatedResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Update get error
+			{
+				Config: ProviderConfig + AuthMappingSettingsUpdatedResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.UpdateAuthMappingSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Update get error
+			{
+				Config: ProviderConfig + AuthMappingSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.GetAuthMappingSettings).Return(nil, fmt.Errorf("mock error")).Build().
+						When(func(ctx context.Context, client *client.Client, zone string) bool {
+							return FunctionMocker.Times() == 2
+						})
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingSettingsResourceErrorCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + AuthMappingSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.UpdateAuthMappingSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingSettingsResourceErrorCopyField(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuthMappingSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "uid_range_min", authMappingUIDRangeMin),
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "gid_range_min", authMappingGIDRangeMin),
+				),
+			},
+			{
+				ResourceName: "powerscale_auth_mapping_settings.test",
+				ImportState:  true,
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.CopyFields).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = Mock(helper.CopyFields).Return(fmt.Errorf("mock error")).Build().
+						When(func(ctx context.Context, source, destination interface{}) bool {
+							return FunctionMocker.Times() == 2
+						})
+				},
+				Config:      ProviderConfig + AuthMappingSettingsUpdatedResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+func TestAccAuthMappingSettingsResourceErrorReadState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock(helper.ReadFromState).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuthMappingSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// Change the settings back to default
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + AuthMappingSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "uid_range_min", authMappingUIDRangeMin),
+					resource.TestCheckResourceAttr("powerscale_auth_mapping_settings.test", "gid_range_min", authMappingGIDRangeMin),
+				),
+			},
+		},
+	})
+}
+
+var authMappingUIDRangeMin = "1000000"
+var authMappingGIDRangeMin = "1000000"
+
+var AuthMappingSettingsResourceConfig = fmt.Sprintf(`
+resource "powerscale_auth_mapping_settings" "test" {
+	uid_range_min = %s
+	gid_range_min = %s
+}
+`, authMappingUIDRangeMin, authMappingGIDRangeMin)
+
+var AuthMappingSettingsUpdatedResourceConfig = `
+resource "powerscale_auth_mapping_settings" "test" {
+	uid_range_min = 1100000
+	gid_range_min = 1100000
+}
+`
@@ -0,0 +1,317 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CloudPoolsAccountResource{}
+	_ resource.ResourceWithConfigure   = &CloudPoolsAccountResource{}
+	_ resource.ResourceWithImportState = &CloudPoolsAccountResource{}
+)
+
+// NewCloudPoolsAccountResource creates a new resource.
+func NewCloudPoolsAccountResource() resource.Resource {
+	return &CloudPoolsAccountResource{}
+}
+
+// CloudPoolsAccountResource defines the resource implementation.
+type CloudPoolsAccountResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *CloudPoolsAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudpools_account"
+}
+
+// Schema describes the resource arguments.
+func (r *CloudPoolsAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the CloudPools accounts on PowerScale Array. " +
+			"A CloudPools account holds the endpoint and the credentials of a cloud storage, such as an S3 compatible object store. " +
+			"We can Create, Update and Delete the CloudPools accounts using this resource. We can also import an existing CloudPools account from PowerScale array.",
+		Description: "This resource is used to manage the CloudPools accounts on PowerScale Array. " +
+			"A CloudPools account holds the endpoint and the credentials of a cloud storage, such as an S3 compatible object store. " +
+			"We can Create, Update and Delete the CloudPools accounts using this resource. We can also import an existing CloudPools account from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the account.",
+				MarkdownDescription: "The system ID given to the account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The account name.",
+				MarkdownDescription: "The account name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of cloud protocol required, such as s3, ecs, azure, google or isilon. Updating this value recreates the account.",
+				MarkdownDescription: "The type of cloud protocol required, such as s3, ecs, azure, google or isilon. Updating this value recreates the account.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Required:            true,
+				Description:         "The URI of the cloud storage endpoint.",
+				MarkdownDescription: "The URI of the cloud storage endpoint.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"account_username": schema.StringAttribute{
+				Required:            true,
+				Description:         "The username of the cloud account, such as the access key of an S3 account.",
+				MarkdownDescription: "The username of the cloud account, such as the access key of an S3 account.",
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				Description:         "The secret key of the cloud account. The key is not returned by the array, so changes made outside of Terraform are not detected.",
+				MarkdownDescription: "The secret key of the cloud account. The key is not returned by the array, so changes made outside of Terraform are not detected.",
+			},
+			"account_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the cloud account, required by some cloud types.",
+				MarkdownDescription: "The ID of the cloud account, required by some cloud types.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the account is enabled.",
+				MarkdownDescription: "Whether the account is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_ssl_validation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the validation of the SSL certificate of the cloud storage endpoint is skipped.",
+				MarkdownDescription: "Whether the validation of the SSL certificate of the cloud storage endpoint is skipped.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_account_check": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the connectivity check of the account is skipped when it is created or updated.",
+				MarkdownDescription: "Whether the connectivity check of the account is skipped when it is created or updated.",
+			},
+			"storage_region": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The region of the cloud storage.",
+				MarkdownDescription: "The region of the cloud storage.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"telemetry_bucket": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The bucket used for telemetry reports.",
+				MarkdownDescription: "The bucket used for telemetry reports.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"proxy": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the network proxy used to connect to the cloud storage.",
+				MarkdownDescription: "The name of the network proxy used to connect to the cloud storage.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The state of the account.",
+				MarkdownDescription: "The state of the account.",
+			},
+			"birth_cluster_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The guid of the cluster on which the account was created.",
+				MarkdownDescription: "The guid of the cluster on which the account was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *CloudPoolsAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *CloudPoolsAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating CloudPools account")
+	var plan models.CloudPoolsAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateCloudPoolsAccount(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateCloudPoolsAccountErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating CloudPools account", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("CloudPools account %s created", id))
+
+	state := plan
+	if err := helper.GetCloudPoolsAccountState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadCloudPoolsAccountErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading CloudPools account after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create CloudPools account completed")
+}
+
+// Read reads the resource state.
+func (r *CloudPoolsAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading CloudPools account")
+	var state models.CloudPoolsAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetCloudPoolsAccountState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadCloudPoolsAccountErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading CloudPools account", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read CloudPools account completed")
+}
+
+// Update updates the resource state.
+func (r *CloudPoolsAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating CloudPools account")
+	var plan, state models.CloudPoolsAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := helper.UpdateCloudPoolsAccount(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.UpdateCloudPoolsAccountErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating CloudPools account", message)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.GetCloudPoolsAccountState(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.ReadCloudPoolsAccountErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading CloudPools account after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update CloudPools account completed")
+}
+
+// Delete deletes the resource.
+func (r *CloudPoolsAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting CloudPools account")
+	var state models.CloudPoolsAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteCloudPoolsAccount(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteCloudPoolsAccountErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting CloudPools account", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete CloudPools account completed")
+}
+
+// ImportState imports the resource state.
+func (r *CloudPoolsAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccCloudPoolsPreCheck skips the CloudPools tests when no S3 compatible store, such as a local MinIO, is configured.
+func testAccCloudPoolsPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"POWERSCALE_CLOUDPOOLS_S3_URI", "POWERSCALE_CLOUDPOOLS_S3_ACCESS_KEY", "POWERSCALE_CLOUDPOOLS_S3_SECRET_KEY"} {
+		if os.Getenv(env) == "" {
+			t.Skipf("%s environment variable not set", env)
+		}
+	}
+}
+
+// testAccCloudPoolsAccountConfig returns the config of an S3 CloudPools account with the given name.
+func testAccCloudPoolsAccountConfig(name string) string {
+	return fmt.Sprintf(`
+resource "powerscale_cloudpools_account" "test" {
+	name = "%s"
+	type = "s3"
+	uri = "%s"
+	account_username = "%s"
+	key = "%s"
+	skip_ssl_validation = true
+}
+`, name, os.Getenv("POWERSCALE_CLOUDPOOLS_S3_URI"), os.Getenv("POWERSCALE_CLOUDPOOLS_S3_ACCESS_KEY"), os.Getenv("POWERSCALE_CLOUDPOOLS_S3_SECRET_KEY"))
+}
+
+// TestAccCloudPoolsAccountResource tests the CloudPools account resource against an S3 compatible store.
+func TestAccCloudPoolsAccountResource(t *testing.T) {
+	resourceName := "powerscale_cloudpools_account.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccCloudPoolsPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account"),
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateCloudPoolsAccount).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_cloud_account"),
+					resource.TestCheckResourceAttr(resourceName, "type", "s3"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "skip_account_check"},
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account_updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_cloud_account_updated"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account"),
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateCloudPoolsAccount).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account_updated"),
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetCloudPoolsAccountState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account_updated"),
+			},
+		},
+	})
+}
@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CloudPoolsPoolResource{}
+	_ resource.ResourceWithConfigure   = &CloudPoolsPoolResource{}
+	_ resource.ResourceWithImportState = &CloudPoolsPoolResource{}
+)
+
+// NewCloudPoolsPoolResource creates a new resource.
+func NewCloudPoolsPoolResource() resource.Resource {
+	return &CloudPoolsPoolResource{}
+}
+
+// CloudPoolsPoolResource defines the resource implementation.
+type CloudPoolsPoolResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *CloudPoolsPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudpools_pool"
+}
+
+// Schema describes the resource arguments.
+func (r *CloudPoolsPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the CloudPools pools on PowerScale Array. " +
+			"A CloudPools pool groups CloudPools accounts, and can be used as the cloud pool target of the file pool policies. " +
+			"We can Create, Update and Delete the CloudPools pools using this resource. We can also import an existing CloudPools pool from PowerScale array.",
+		Description: "This resource is used to manage the CloudPools pools on PowerScale Array. " +
+			"A CloudPools pool groups CloudPools accounts, and can be used as the cloud pool target of the file pool policies. " +
+			"We can Create, Update and Delete the CloudPools pools using this resource. We can also import an existing CloudPools pool from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the pool.",
+				MarkdownDescription: "The system ID given to the pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The pool name.",
+				MarkdownDescription: "The pool name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of cloud protocol required, which must match the type of the accounts. Updating this value recreates the pool.",
+				MarkdownDescription: "The type of cloud protocol required, which must match the type of the accounts. Updating this value recreates the pool.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accounts": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "The names of the CloudPools accounts in the pool.",
+				MarkdownDescription: "The names of the CloudPools accounts in the pool.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The description of the pool.",
+				MarkdownDescription: "The description of the pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vendor": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The vendor of the cloud storage.",
+				MarkdownDescription: "The vendor of the cloud storage.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The state of the pool.",
+				MarkdownDescription: "The state of the pool.",
+			},
+			"birth_cluster_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The guid of the cluster on which the pool was created.",
+				MarkdownDescription: "The guid of the cluster on which the pool was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *CloudPoolsPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *CloudPoolsPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating CloudPools pool")
+	var plan models.CloudPoolsPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateCloudPoolsPool(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateCloudPoolsPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating CloudPools pool", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("CloudPools pool %s created", id))
+
+	state := plan
+	if err := helper.GetCloudPoolsPoolState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadCloudPoolsPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading CloudPools pool after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create CloudPools pool completed")
+}
+
+// Read reads the resource state.
+func (r *CloudPoolsPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading CloudPools pool")
+	var state models.CloudPoolsPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetCloudPoolsPoolState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadCloudPoolsPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading CloudPools pool", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read CloudPools pool completed")
+}
+
+// Update updates the resource state.
+func (r *CloudPoolsPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating CloudPools pool")
+	var plan, state models.CloudPoolsPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := helper.UpdateCloudPoolsPool(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.UpdateCloudPoolsPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating CloudPools pool", message)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.GetCloudPoolsPoolState(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.ReadCloudPoolsPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading CloudPools pool after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update CloudPools pool completed")
+}
+
+// Delete deletes the resource.
+func (r *CloudPoolsPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting CloudPools pool")
+	var state models.CloudPoolsPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteCloudPoolsPool(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteCloudPoolsPoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting CloudPools pool", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete CloudPools pool completed")
+}
+
+// ImportState imports the resource state.
+func (r *CloudPoolsPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
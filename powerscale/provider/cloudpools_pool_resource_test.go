@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccCloudPoolsPoolResource tests the CloudPools pool resource.
+func TestAccCloudPoolsPoolResource(t *testing.T) {
+	resourceName := "powerscale_cloudpools_pool.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccCloudPoolsPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account") + testAccCloudPoolsPoolResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateCloudPoolsPool).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account") + testAccCloudPoolsPoolResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_cloud_pool"),
+					resource.TestCheckResourceAttr(resourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "accounts.0", "tfacc_cloud_account"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account") + testAccCloudPoolsPoolResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "tfacc cloud pool"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account") + testAccCloudPoolsPoolResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateCloudPoolsPool).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account") + testAccCloudPoolsPoolResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetCloudPoolsPoolState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account") + testAccCloudPoolsPoolResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccCloudPoolsPoolResourceConfig = `
+resource "powerscale_cloudpools_pool" "test" {
+	name = "tfacc_cloud_pool"
+	type = "s3"
+	accounts = [powerscale_cloudpools_account.test.name]
+}
+`
+
+var testAccCloudPoolsPoolResourceUpdateConfig = `
+resource "powerscale_cloudpools_pool" "test" {
+	name = "tfacc_cloud_pool"
+	type = "s3"
+	accounts = [powerscale_cloudpools_account.test.name]
+	description = "tfacc cloud pool"
+}
+`
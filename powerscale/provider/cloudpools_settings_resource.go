@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CloudPoolsSettingsResource{}
+	_ resource.ResourceWithConfigure   = &CloudPoolsSettingsResource{}
+	_ resource.ResourceWithImportState = &CloudPoolsSettingsResource{}
+)
+
+// NewCloudPoolsSettingsResource creates a new resource.
+func NewCloudPoolsSettingsResource() resource.Resource {
+	return &CloudPoolsSettingsResource{}
+}
+
+// CloudPoolsSettingsResource defines the resource implementation.
+type CloudPoolsSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *CloudPoolsSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudpools_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *CloudPoolsSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the CloudPools settings entity of PowerScale Array. We can Create, Update and Delete the CloudPools settings using this resource. " +
+			"We can also import the existing CloudPools settings from PowerScale array. The settings provide the default values of the cloud policies of the file pool policies. " +
+			"Note that CloudPools settings cannot be deleted, deleting the resource only removes it from the state.",
+		Description: "This resource is used to manage the CloudPools settings entity of PowerScale Array. We can Create, Update and Delete the CloudPools settings using this resource. " +
+			"We can also import the existing CloudPools settings from PowerScale array. The settings provide the default values of the cloud policies of the file pool policies. " +
+			"Note that CloudPools settings cannot be deleted, deleting the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the CloudPools settings.",
+				MarkdownDescription: "ID of the CloudPools settings.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"archive_snapshot_files": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies if files with snapshots should be archived by default.",
+				MarkdownDescription: "Specifies if files with snapshots should be archived by default.",
+			},
+			"cache_expiration": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the default cache expiration.",
+				MarkdownDescription: "Specifies the default cache expiration.",
+			},
+			"cache_read_ahead": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the default cache read ahead type. Acceptable values: partial, full.",
+				MarkdownDescription: "Specifies the default cache read ahead type. Acceptable values: partial, full.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("partial", "full"),
+				},
+			},
+			"cache_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the default cache type. Acceptable values: cached, no-cache.",
+				MarkdownDescription: "Specifies the default cache type. Acceptable values: cached, no-cache.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("cached", "no-cache"),
+				},
+			},
+			"compression": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies if files should be compressed by default.",
+				MarkdownDescription: "Specifies if files should be compressed by default.",
+			},
+			"data_retention": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the default minimum amount of time archived data will be retained in the cloud after deletion.",
+				MarkdownDescription: "Specifies the default minimum amount of time archived data will be retained in the cloud after deletion.",
+			},
+			"encryption": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies if files should be encrypted by default.",
+				MarkdownDescription: "Specifies if files should be encrypted by default.",
+			},
+			"full_backup_retention": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The default minimum amount of time cloud files will be retained after the creation of a full NDMP backup.",
+				MarkdownDescription: "The default minimum amount of time cloud files will be retained after the creation of a full NDMP backup.",
+			},
+			"incremental_backup_retention": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The default minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup.",
+				MarkdownDescription: "The default minimum amount of time cloud files will be retained after the creation of a SyncIQ backup or an incremental NDMP backup.",
+			},
+			"writeback_frequency": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The default minimum amount of time to wait before updating cloud data with local changes.",
+				MarkdownDescription: "The default minimum amount of time to wait before updating cloud data with local changes.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *CloudPoolsSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *CloudPoolsSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating CloudPools settings")
+	var plan models.CloudPoolsSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateCloudPoolsSettings(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateCloudPoolsSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating CloudPools settings", message)
+		return
+	}
+
+	var state models.CloudPoolsSettingsResourceModel
+	if err := helper.GetCloudPoolsSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadCloudPoolsSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating CloudPools settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create CloudPools settings completed")
+}
+
+// Read reads the resource state.
+func (r *CloudPoolsSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading CloudPools settings")
+	var state models.CloudPoolsSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetCloudPoolsSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadCloudPoolsSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading CloudPools settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read CloudPools settings completed")
+}
+
+// Update updates the resource state.
+func (r *CloudPoolsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating CloudPools settings")
+	var plan models.CloudPoolsSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateCloudPoolsSettings(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateCloudPoolsSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating CloudPools settings", message)
+		return
+	}
+
+	var state models.CloudPoolsSettingsResourceModel
+	if err := helper.GetCloudPoolsSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadCloudPoolsSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating CloudPools settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Update CloudPools settings completed")
+}
+
+// Delete removes the CloudPools settings from the state.
+func (r *CloudPoolsSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting CloudPools settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete CloudPools settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *CloudPoolsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state models.CloudPoolsSettingsResourceModel
+	if err := helper.GetCloudPoolsSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadCloudPoolsSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing CloudPools settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
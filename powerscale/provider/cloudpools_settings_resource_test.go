@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccCloudPoolsSettingsResource tests the CloudPools settings resource.
+func TestAccCloudPoolsSettingsResource(t *testing.T) {
+	resourceName := "powerscale_cloudpools_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid cache type
+			{
+				Config:      ProviderConfig + testAccCloudPoolsSettingsResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccCloudPoolsSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateCloudPoolsSettings).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccCloudPoolsSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cloudpools_settings"),
+					resource.TestCheckResourceAttr(resourceName, "cache_read_ahead", "partial"),
+					resource.TestCheckResourceAttr(resourceName, "compression", "true"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccCloudPoolsSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cache_read_ahead", "full"),
+					resource.TestCheckResourceAttr(resourceName, "compression", "false"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccCloudPoolsSettingsResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetCloudPoolsSettingsState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// restore the settings
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccCloudPoolsSettingsResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccCloudPoolsSettingsResourceConfig = `
+resource "powerscale_cloudpools_settings" "test" {
+	cache_read_ahead = "partial"
+	compression = true
+}
+`
+
+var testAccCloudPoolsSettingsResourceUpdateConfig = `
+resource "powerscale_cloudpools_settings" "test" {
+	cache_read_ahead = "full"
+	compression = false
+}
+`
+
+var testAccCloudPoolsSettingsResourceInvalidConfig = `
+resource "powerscale_cloudpools_settings" "test" {
+	cache_type = "invalid"
+}
+`
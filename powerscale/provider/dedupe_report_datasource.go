@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DedupeReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &DedupeReportDataSource{}
+)
+
+// NewDedupeReportDataSource creates a new data source.
+func NewDedupeReportDataSource() datasource.DataSource {
+	return &DedupeReportDataSource{}
+}
+
+// DedupeReportDataSource defines the data source implementation.
+type DedupeReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *DedupeReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedupe_report"
+}
+
+// Schema describes the data source arguments.
+func (d *DedupeReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the existing dedupe reports from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing dedupe reports from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"dedupe_reports": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of dedupe reports.",
+				MarkdownDescription: "List of dedupe reports.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The system ID given to the report.",
+							MarkdownDescription: "The system ID given to the report.",
+						},
+						"job_id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the job which generated the report.",
+							MarkdownDescription: "The ID of the job which generated the report.",
+						},
+						"job_type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of the job which generated the report.",
+							MarkdownDescription: "The type of the job which generated the report.",
+						},
+						"time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Time the report was created, in unix epoch seconds.",
+							MarkdownDescription: "Time the report was created, in unix epoch seconds.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching dedupe reports.",
+				MarkdownDescription: "Filters for fetching dedupe reports.",
+				Attributes: map[string]schema.Attribute{
+					"job_id": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Only list reports generated by this job.",
+						MarkdownDescription: "Only list reports generated by this job.",
+					},
+					"job_type": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list reports generated by this job type.",
+						MarkdownDescription: "Only list reports generated by this job type.",
+					},
+					"begin": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Only list reports created after this time, in unix epoch seconds.",
+						MarkdownDescription: "Only list reports created after this time, in unix epoch seconds.",
+					},
+					"end": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Only list reports created before this time, in unix epoch seconds.",
+						MarkdownDescription: "Only list reports created before this time, in unix epoch seconds.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *DedupeReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *DedupeReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.DedupeReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports, err := helper.ListDedupeReports(ctx, d.client, data.Filter)
+	if err != nil {
+		errStr := constants.ListDedupeReportsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading dedupe reports", message)
+		return
+	}
+
+	state, err := helper.NewDedupeReportDataSource(ctx, reports)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map dedupe report fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
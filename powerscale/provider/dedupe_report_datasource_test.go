@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDedupeReportDataSource tests the dedupe report data source.
+func TestAccDedupeReportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// list all
+			{
+				Config: ProviderConfig + testAccDedupeReportDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_dedupe_report.all", "id", "dedupe_report_datasource"),
+					resource.TestCheckResourceAttrSet("data.powerscale_dedupe_report.all", "dedupe_reports.#"),
+				),
+			},
+			// filter
+			{
+				Config: ProviderConfig + testAccDedupeReportDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_dedupe_report.filtered", "dedupe_reports.#", "0"),
+				),
+			},
+			// list error
+			{
+				Config: ProviderConfig + testAccDedupeReportDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListDedupeReports).Return(nil, fmt.Errorf("mock list error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock list error.*`),
+			},
+			// mapping error
+			{
+				Config: ProviderConfig + testAccDedupeReportDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.NewDedupeReportDataSource).Return(nil, fmt.Errorf("mock mapping error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock mapping error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccDedupeReportDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccDedupeReportDataSourceConfig = `
+data "powerscale_dedupe_report" "all" {
+}
+`
+
+var testAccDedupeReportDataSourceFilterConfig = `
+data "powerscale_dedupe_report" "filtered" {
+	filter {
+		begin = 1
+		end = 2
+	}
+}
+`
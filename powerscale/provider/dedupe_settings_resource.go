@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DedupeSettingsResource{}
+	_ resource.ResourceWithConfigure   = &DedupeSettingsResource{}
+	_ resource.ResourceWithImportState = &DedupeSettingsResource{}
+)
+
+// NewDedupeSettingsResource creates a new resource.
+func NewDedupeSettingsResource() resource.Resource {
+	return &DedupeSettingsResource{}
+}
+
+// DedupeSettingsResource defines the resource implementation.
+type DedupeSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *DedupeSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedupe_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *DedupeSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the SmartDedupe settings entity of PowerScale Array. We can Create, Update and Delete the dedupe settings using this resource. " +
+			"We can also import the existing dedupe settings from PowerScale array. Note that dedupe settings cannot be deleted, deleting the resource only removes it from the state.",
+		Description: "This resource is used to manage the SmartDedupe settings entity of PowerScale Array. We can Create, Update and Delete the dedupe settings using this resource. " +
+			"We can also import the existing dedupe settings from PowerScale array. Note that dedupe settings cannot be deleted, deleting the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the dedupe settings.",
+				MarkdownDescription: "ID of the dedupe settings.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The paths that will be deduplicated.",
+				MarkdownDescription: "The paths that will be deduplicated.",
+			},
+			"assess_paths": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The paths that will be assessed for the space savings of deduplication.",
+				MarkdownDescription: "The paths that will be assessed for the space savings of deduplication.",
+			},
+			"dedupe_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the Dedupe job, which deduplicates the paths, is enabled.",
+				MarkdownDescription: "Whether the Dedupe job, which deduplicates the paths, is enabled.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *DedupeSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *DedupeSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating dedupe settings")
+	var plan models.DedupeSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateDedupeSettings(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateDedupeSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating dedupe settings", message)
+		return
+	}
+
+	var state models.DedupeSettingsResourceModel
+	if err := helper.GetDedupeSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadDedupeSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating dedupe settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create dedupe settings completed")
+}
+
+// Read reads the resource state.
+func (r *DedupeSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading dedupe settings")
+	var state models.DedupeSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetDedupeSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadDedupeSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading dedupe settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read dedupe settings completed")
+}
+
+// Update updates the resource state.
+func (r *DedupeSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating dedupe settings")
+	var plan models.DedupeSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateDedupeSettings(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateDedupeSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating dedupe settings", message)
+		return
+	}
+
+	var state models.DedupeSettingsResourceModel
+	if err := helper.GetDedupeSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadDedupeSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating dedupe settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Update dedupe settings completed")
+}
+
+// Delete removes the dedupe settings from the state.
+func (r *DedupeSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting dedupe settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete dedupe settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *DedupeSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state models.DedupeSettingsResourceModel
+	if err := helper.GetDedupeSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadDedupeSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing dedupe settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
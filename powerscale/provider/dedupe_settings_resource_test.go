@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDedupeSettingsResource tests the dedupe settings resource.
+func TestAccDedupeSettingsResource(t *testing.T) {
+	resourceName := "powerscale_dedupe_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccDedupeSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateDedupeSettings).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccDedupeSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "dedupe_settings"),
+					resource.TestCheckResourceAttr(resourceName, "assess_paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "assess_paths.0", "/ifs"),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dedupe_enabled", "false"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccDedupeSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "assess_paths.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "paths.0", "/ifs"),
+					resource.TestCheckResourceAttr(resourceName, "dedupe_enabled", "true"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccDedupeSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateDedupeSettings).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccDedupeSettingsResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetDedupeSettingsState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// restore the settings
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccDedupeSettingsResourceRestoreConfig,
+			},
+		},
+	})
+}
+
+var testAccDedupeSettingsResourceConfig = `
+resource "powerscale_dedupe_settings" "test" {
+	paths = []
+	assess_paths = ["/ifs"]
+	dedupe_enabled = false
+}
+`
+
+var testAccDedupeSettingsResourceUpdateConfig = `
+resource "powerscale_dedupe_settings" "test" {
+	paths = ["/ifs"]
+	assess_paths = []
+	dedupe_enabled = true
+}
+`
+
+var testAccDedupeSettingsResourceRestoreConfig = `
+resource "powerscale_dedupe_settings" "test" {
+	paths = []
+	assess_paths = []
+	dedupe_enabled = false
+}
+`
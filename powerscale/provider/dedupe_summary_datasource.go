@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DedupeSummaryDataSource{}
+	_ datasource.DataSourceWithConfigure = &DedupeSummaryDataSource{}
+)
+
+// NewDedupeSummaryDataSource creates a new data source.
+func NewDedupeSummaryDataSource() datasource.DataSource {
+	return &DedupeSummaryDataSource{}
+}
+
+// DedupeSummaryDataSource defines the data source implementation.
+type DedupeSummaryDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *DedupeSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedupe_summary"
+}
+
+// Schema describes the data source arguments.
+func (d *DedupeSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the space savings of SmartDedupe from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the space savings of SmartDedupe from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"summary": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "The space savings of deduplication.",
+				MarkdownDescription: "The space savings of deduplication.",
+				Attributes: map[string]schema.Attribute{
+					"block_size": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The size of a block in bytes.",
+						MarkdownDescription: "The size of a block in bytes.",
+					},
+					"estimated_physical_blocks": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The estimated number of physical blocks that deduplication would use.",
+						MarkdownDescription: "The estimated number of physical blocks that deduplication would use.",
+					},
+					"estimated_saved_blocks": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The estimated number of blocks that deduplication would save.",
+						MarkdownDescription: "The estimated number of blocks that deduplication would save.",
+					},
+					"logical_blocks": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The number of logical blocks of the deduplicated files.",
+						MarkdownDescription: "The number of logical blocks of the deduplicated files.",
+					},
+					"saved_logical_blocks": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The number of logical blocks saved by deduplication.",
+						MarkdownDescription: "The number of logical blocks saved by deduplication.",
+					},
+					"total_blocks": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The total number of blocks of the cluster.",
+						MarkdownDescription: "The total number of blocks of the cluster.",
+					},
+					"used_blocks": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The number of blocks used on the cluster.",
+						MarkdownDescription: "The number of blocks used on the cluster.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *DedupeSummaryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *DedupeSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	summary, err := helper.GetDedupeSummary(ctx, d.client)
+	if err != nil {
+		errStr := constants.ReadDedupeSummaryErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading dedupe summary", message)
+		return
+	}
+
+	state, err := helper.NewDedupeSummaryDataSource(ctx, summary)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map dedupe summary fields", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDedupeSummaryDataSource tests the dedupe summary data source.
+func TestAccDedupeSummaryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + testAccDedupeSummaryDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_dedupe_summary.test", "id", "dedupe_summary_datasource"),
+					resource.TestCheckResourceAttrSet("data.powerscale_dedupe_summary.test", "summary.block_size"),
+					resource.TestCheckResourceAttrSet("data.powerscale_dedupe_summary.test", "summary.total_blocks"),
+					resource.TestCheckResourceAttrSet("data.powerscale_dedupe_summary.test", "summary.saved_logical_blocks"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccDedupeSummaryDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetDedupeSummary).Return(nil, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// mapping error
+			{
+				Config: ProviderConfig + testAccDedupeSummaryDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.NewDedupeSummaryDataSource).Return(nil, fmt.Errorf("mock mapping error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock mapping error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccDedupeSummaryDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccDedupeSummaryDataSourceConfig = `
+data "powerscale_dedupe_summary" "test" {
+}
+`
@@ -0,0 +1,162 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &FileDataSource{}
+	_ datasource.DataSourceWithConfigure = &FileDataSource{}
+)
+
+// NewFileDataSource creates a new data source.
+func NewFileDataSource() datasource.DataSource {
+	return &FileDataSource{}
+}
+
+// FileDataSource defines the data source implementation.
+type FileDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *FileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema describes the data source arguments.
+func (d *FileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the metadata and optionally the content of a file from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the metadata and optionally the content of a file from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The path of the file.",
+				MarkdownDescription: "The path of the file.",
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				Description:         "The absolute path of the file, for example /ifs/data/file.txt.",
+				MarkdownDescription: "The absolute path of the file, for example /ifs/data/file.txt.",
+			},
+			"include_content": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to read the content of the file. Defaults to false.",
+				MarkdownDescription: "Whether to read the content of the file. Defaults to false.",
+			},
+			"content": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The content of the file, as a UTF-8 string. Only set when include_content is true.",
+				MarkdownDescription: "The content of the file, as a UTF-8 string. Only set when `include_content` is true.",
+			},
+			"content_base64": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The content of the file, base64 encoded. Only set when include_content is true.",
+				MarkdownDescription: "The content of the file, base64 encoded. Only set when `include_content` is true.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The SHA-256 checksum of the content of the file. Only set when include_content is true.",
+				MarkdownDescription: "The SHA-256 checksum of the content of the file. Only set when `include_content` is true.",
+			},
+			"owner": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the user owning the file.",
+				MarkdownDescription: "The name of the user owning the file.",
+			},
+			"group": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the group of the file.",
+				MarkdownDescription: "The name of the group of the file.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The POSIX mode of the file.",
+				MarkdownDescription: "The POSIX mode of the file.",
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The size of the file in bytes.",
+				MarkdownDescription: "The size of the file in bytes.",
+			},
+			"last_modified": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The time the file was last modified.",
+				MarkdownDescription: "The time the file was last modified.",
+			},
+			"content_type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The content type of the file.",
+				MarkdownDescription: "The content type of the file.",
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *FileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *FileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.FileDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetFileDataSourceState(ctx, d.client, &data); err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file", message)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
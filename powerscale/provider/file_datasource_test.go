@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFileDataSource tests the file data source.
+func TestAccFileDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// metadata and content
+			{
+				Config: ProviderConfig + testAccFileDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_file.metadata", "size", "12"),
+					resource.TestCheckNoResourceAttr("data.powerscale_file.metadata", "content"),
+					resource.TestCheckResourceAttr("data.powerscale_file.content", "content", "hello world\n"),
+					resource.TestCheckResourceAttrPair("data.powerscale_file.content", "content_sha256", "powerscale_file.file", "content_sha256"),
+					resource.TestCheckResourceAttrPair("data.powerscale_file.content", "owner", "powerscale_file.file", "owner"),
+				),
+			},
+			// missing file
+			{
+				Config:      ProviderConfig + testAccFileDataSourceMissingConfig,
+				ExpectError: regexp.MustCompile(`.*does not exist.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccFileDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetFileDataSourceState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccFileDataSourceConfig = `
+resource "powerscale_file" "file" {
+	path = "/ifs/tfacc_file_datasource.txt"
+	content = "hello world\n"
+	overwrite = true
+}
+
+data "powerscale_file" "metadata" {
+	path = powerscale_file.file.path
+}
+
+data "powerscale_file" "content" {
+	path = powerscale_file.file.path
+	include_content = true
+}
+`
+
+var testAccFileDataSourceMissingConfig = `
+data "powerscale_file" "missing" {
+	path = "/ifs/tfacc_file_does_not_exist.txt"
+}
+`
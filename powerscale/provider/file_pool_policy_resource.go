@@ -217,8 +217,8 @@ func (r *FilePoolPolicyResource) Schema(ctx context.Context, req resource.Schema
 							Optional:            true,
 							Attributes: map[string]schema.Attribute{
 								"pool": schema.StringAttribute{
-									Description:         "Specifies the cloudPool storage target.",
-									MarkdownDescription: "Specifies the cloudPool storage target.",
+									Description:         "Specifies the cloudPool storage target. Set it to the name of a powerscale_cloudpools_pool resource, such as powerscale_cloudpools_pool.example.name, to create the pool before the policy.",
+									MarkdownDescription: "Specifies the cloudPool storage target. Set it to the name of a `powerscale_cloudpools_pool` resource, such as `powerscale_cloudpools_pool.example.name`, to create the pool before the policy.",
 									Required:            true,
 								},
 								"archive_snapshot_files": schema.BoolAttribute{
@@ -74,6 +74,35 @@ func TestAccFilePoolPolicyResource(t *testing.T) {
 	})
 }
 
+func TestAccFilePoolPolicyResourceCloudPoolsPool(t *testing.T) {
+	var policyResourceName = "powerscale_filepool_policy.policy_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccCloudPoolsPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a policy targeting a CloudPools pool managed by the provider
+			{
+				Config: ProviderConfig + testAccCloudPoolsAccountConfig("tfacc_cloud_account") + filePoolPolicyCloudPoolsPoolResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(policyResourceName, "name", "tfacc_filePoolPolicy_cloud"),
+					resource.TestCheckResourceAttr(policyResourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(policyResourceName, "actions.0.action_type", "set_cloudpool_policy"),
+					resource.TestCheckResourceAttrPair(policyResourceName, "actions.0.cloudpool_policy_action.pool", "powerscale_cloudpools_pool.test", "name"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: policyResourceName,
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "tfacc_cloud_pool", states[0].Attributes["actions.0.cloudpool_policy_action.pool"])
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccFilePoolPolicyResourceErr(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -654,6 +683,40 @@ resource "powerscale_filepool_policy" "policy_test" {
 }
 `
 
+var filePoolPolicyCloudPoolsPoolResourceConfig = `
+resource "powerscale_cloudpools_pool" "test" {
+  name = "tfacc_cloud_pool"
+  type = "s3"
+  accounts = [powerscale_cloudpools_account.test.name]
+}
+
+resource "powerscale_filepool_policy" "policy_test" {
+  name = "tfacc_filePoolPolicy_cloud"
+  file_matching_pattern = {
+    or_criteria = [
+      {
+        and_criteria = [
+          {
+            operator = ">"
+            type = "size"
+            units = "B"
+            value = "1073741824"
+          }
+        ]
+      }
+    ]
+  }
+  actions = [
+    {
+      action_type = "set_cloudpool_policy"
+      cloudpool_policy_action = {
+        pool = powerscale_cloudpools_pool.test.name
+      }
+    }
+  ]
+}
+`
+
 var filePoolPolicyResourceUpdateConfig = `
 resource "powerscale_filepool_policy" "policy_test" {
 	name = "tfacc_filePoolPolicy_update"
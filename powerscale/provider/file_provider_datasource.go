@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &FileProviderDataSource{}
+	_ datasource.DataSourceWithConfigure = &FileProviderDataSource{}
+)
+
+// NewFileProviderDataSource creates a new data source.
+func NewFileProviderDataSource() datasource.DataSource {
+	return &FileProviderDataSource{}
+}
+
+// FileProviderDataSource defines the data source implementation.
+type FileProviderDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *FileProviderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_provider"
+}
+
+// Schema describes the data source arguments.
+func (d *FileProviderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the existing file providers from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing file providers from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"file_providers": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of file providers.",
+				MarkdownDescription: "List of file providers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the file provider, which is its name.",
+							MarkdownDescription: "The ID of the file provider, which is its name.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the name of the file provider.",
+							MarkdownDescription: "Specifies the name of the file provider.",
+						},
+						"password_file": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the location of the file that contains information about users.",
+							MarkdownDescription: "Specifies the location of the file that contains information about users.",
+						},
+						"group_file": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the location of the file that contains information about the group.",
+							MarkdownDescription: "Specifies the location of the file that contains information about the group.",
+						},
+						"netgroup_file": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the path to a netgroups replacement file.",
+							MarkdownDescription: "Specifies the path to a netgroups replacement file.",
+						},
+						"modifiable_password_file": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables modification of the password file.",
+							MarkdownDescription: "If true, enables modification of the password file.",
+						},
+						"modifiable_group_file": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables modification of the group file.",
+							MarkdownDescription: "If true, enables modification of the group file.",
+						},
+						"authentication": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables authentication and identity management through the authentication provider.",
+							MarkdownDescription: "If true, enables authentication and identity management through the authentication provider.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables the file provider.",
+							MarkdownDescription: "If true, enables the file provider.",
+						},
+						"create_home_directory": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Automatically create the home directory on the first login.",
+							MarkdownDescription: "Automatically create the home directory on the first login.",
+						},
+						"home_directory_template": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the path to the home directory template.",
+							MarkdownDescription: "Specifies the path to the home directory template.",
+						},
+						"login_shell": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the login shell path.",
+							MarkdownDescription: "Specifies the login shell path.",
+						},
+						"enumerate_users": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables the provider to enumerate users.",
+							MarkdownDescription: "If true, enables the provider to enumerate users.",
+						},
+						"enumerate_groups": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables the provider to enumerate groups.",
+							MarkdownDescription: "If true, enables the provider to enumerate groups.",
+						},
+						"user_domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the domain for this provider through which users are qualified.",
+							MarkdownDescription: "Specifies the domain for this provider through which users are qualified.",
+						},
+						"group_domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the domain for this provider through which groups are qualified.",
+							MarkdownDescription: "Specifies the domain for this provider through which groups are qualified.",
+						},
+						"provider_domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the domain for the provider.",
+							MarkdownDescription: "Specifies the domain for the provider.",
+						},
+						"ntlm_support": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+							MarkdownDescription: "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+						},
+						"normalize_users": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Normalizes user names to lowercase before look up.",
+							MarkdownDescription: "Normalizes user names to lowercase before look up.",
+						},
+						"normalize_groups": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Normalizes group names to lowercase before look up.",
+							MarkdownDescription: "Normalizes group names to lowercase before look up.",
+						},
+						"restrict_findable": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+							MarkdownDescription: "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+						},
+						"restrict_listable": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+							MarkdownDescription: "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+						},
+						"zone_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the name of the access zone in which this provider was created.",
+							MarkdownDescription: "Specifies the name of the access zone in which this provider was created.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the status of the provider.",
+							MarkdownDescription: "Specifies the status of the provider.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching file providers.",
+				MarkdownDescription: "Filters for fetching file providers.",
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Only list the file providers with these names.",
+						MarkdownDescription: "Only list the file providers with these names.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *FileProviderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *FileProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.FileProviderDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := helper.ListFileProviders(ctx, d.client)
+	if err != nil {
+		errStr := constants.ListFileProvidersErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file providers", message)
+		return
+	}
+
+	state, err := helper.NewFileProviderDataSource(ctx, providers, data.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map file provider fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFileProviderDataSource tests the file provider data source.
+func TestAccFileProviderDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// list all
+			{
+				Config: ProviderConfig + testAccFileProviderDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_file_provider.all", "id", "file_provider_datasource"),
+					resource.TestCheckResourceAttrSet("data.powerscale_file_provider.all", "file_providers.#"),
+				),
+			},
+			// filter
+			{
+				Config: ProviderConfig + testAccFileProviderDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_file_provider.filtered", "file_providers.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_file_provider.filtered", "file_providers.0.name", "tfacc_file_provider"),
+					resource.TestCheckResourceAttr("data.powerscale_file_provider.filtered", "file_providers.0.id", "tfacc_file_provider"),
+				),
+			},
+			// invalid filter
+			{
+				Config:      ProviderConfig + testAccFileProviderDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*could not find file provider.*`),
+			},
+			// list error
+			{
+				Config: ProviderConfig + testAccFileProviderDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListFileProviders).Return(nil, fmt.Errorf("mock list error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock list error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileProviderDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccFileProviderDataSourceConfig = `
+resource "powerscale_file_provider" "test" {
+	name = "tfacc_file_provider"
+}
+
+data "powerscale_file_provider" "all" {
+	depends_on = [powerscale_file_provider.test]
+}
+`
+
+var testAccFileProviderDataSourceFilterConfig = testAccFileProviderDataSourceConfig + `
+data "powerscale_file_provider" "filtered" {
+	depends_on = [powerscale_file_provider.test]
+	filter {
+		names = ["tfacc_file_provider"]
+	}
+}
+`
+
+var testAccFileProviderDataSourceInvalidConfig = `
+data "powerscale_file_provider" "invalid" {
+	filter {
+		names = ["tfacc_invalid_file_provider"]
+	}
+}
+`
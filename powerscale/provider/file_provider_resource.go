@@ -0,0 +1,339 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &FileProviderResource{}
+	_ resource.ResourceWithConfigure   = &FileProviderResource{}
+	_ resource.ResourceWithImportState = &FileProviderResource{}
+)
+
+// NewFileProviderResource creates a new resource.
+func NewFileProviderResource() resource.Resource {
+	return &FileProviderResource{}
+}
+
+// FileProviderResource defines the resource implementation.
+type FileProviderResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *FileProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_provider"
+}
+
+// Schema describes the resource arguments.
+func (r *FileProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the file provider entity of PowerScale Array. A file provider authenticates users and groups from passwd, group and netgroup files. We can Create, Update and Delete the file provider using this resource. We can also import an existing file provider from PowerScale array.",
+		Description:         "This resource is used to manage the file provider entity of PowerScale Array. A file provider authenticates users and groups from passwd, group and netgroup files. We can Create, Update and Delete the file provider using this resource. We can also import an existing file provider from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the file provider, which is its name.",
+				MarkdownDescription: "The ID of the file provider, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the name of the file provider. Updating this value recreates the file provider.",
+				MarkdownDescription: "Specifies the name of the file provider. Updating this value recreates the file provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password_file": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the location of the file that contains information about users.",
+				MarkdownDescription: "Specifies the location of the file that contains information about users.",
+			},
+			"group_file": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the location of the file that contains information about the group.",
+				MarkdownDescription: "Specifies the location of the file that contains information about the group.",
+			},
+			"netgroup_file": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the path to a netgroups replacement file.",
+				MarkdownDescription: "Specifies the path to a netgroups replacement file.",
+			},
+			"modifiable_password_file": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables modification of the password file.",
+				MarkdownDescription: "If true, enables modification of the password file.",
+			},
+			"modifiable_group_file": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables modification of the group file.",
+				MarkdownDescription: "If true, enables modification of the group file.",
+			},
+			"authentication": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables authentication and identity management through the authentication provider.",
+				MarkdownDescription: "If true, enables authentication and identity management through the authentication provider.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables the file provider.",
+				MarkdownDescription: "If true, enables the file provider.",
+			},
+			"create_home_directory": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Automatically create the home directory on the first login.",
+				MarkdownDescription: "Automatically create the home directory on the first login.",
+			},
+			"home_directory_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the path to the home directory template.",
+				MarkdownDescription: "Specifies the path to the home directory template.",
+			},
+			"login_shell": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the login shell path.",
+				MarkdownDescription: "Specifies the login shell path.",
+			},
+			"enumerate_users": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables the provider to enumerate users.",
+				MarkdownDescription: "If true, enables the provider to enumerate users.",
+			},
+			"enumerate_groups": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables the provider to enumerate groups.",
+				MarkdownDescription: "If true, enables the provider to enumerate groups.",
+			},
+			"user_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the domain for this provider through which users are qualified.",
+				MarkdownDescription: "Specifies the domain for this provider through which users are qualified.",
+			},
+			"group_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the domain for this provider through which groups are qualified.",
+				MarkdownDescription: "Specifies the domain for this provider through which groups are qualified.",
+			},
+			"provider_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the domain for the provider.",
+				MarkdownDescription: "Specifies the domain for the provider.",
+			},
+			"ntlm_support": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+				MarkdownDescription: "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "v2only", "none"),
+				},
+			},
+			"normalize_users": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Normalizes user names to lowercase before look up.",
+				MarkdownDescription: "Normalizes user names to lowercase before look up.",
+			},
+			"normalize_groups": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Normalizes group names to lowercase before look up.",
+				MarkdownDescription: "Normalizes group names to lowercase before look up.",
+			},
+			"restrict_findable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+				MarkdownDescription: "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+			},
+			"restrict_listable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+				MarkdownDescription: "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+			},
+			"zone_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the name of the access zone in which this provider was created.",
+				MarkdownDescription: "Specifies the name of the access zone in which this provider was created.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the status of the provider.",
+				MarkdownDescription: "Specifies the status of the provider.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *FileProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *FileProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating file provider")
+	var plan models.FileProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.CreateFileProvider(ctx, r.client, &plan); err != nil {
+		errStr := constants.CreateFileProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating file provider", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetFileProviderState(ctx, r.client, plan.Name.ValueString(), &state); err != nil {
+		errStr := constants.ReadFileProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file provider after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create file provider completed")
+}
+
+// Read reads the resource state.
+func (r *FileProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading file provider")
+	var state models.FileProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetFileProviderState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadFileProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file provider", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read file provider completed")
+}
+
+// Update updates the resource state.
+func (r *FileProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating file provider")
+	var plan, state models.FileProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateFileProvider(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateFileProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating file provider", message)
+		return
+	}
+
+	if err := helper.GetFileProviderState(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadFileProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file provider after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update file provider completed")
+}
+
+// Delete deletes the resource.
+func (r *FileProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting file provider")
+	var state models.FileProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteFileProvider(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteFileProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting file provider", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete file provider completed")
+}
+
+// ImportState imports the resource state.
+func (r *FileProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
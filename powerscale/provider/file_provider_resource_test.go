@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFileProviderResource tests the file provider resource.
+func TestAccFileProviderResource(t *testing.T) {
+	resourceName := "powerscale_file_provider.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccFileProviderResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateFileProvider).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileProviderResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tfacc_file_provider"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_file_provider"),
+					resource.TestCheckResourceAttr(resourceName, "modifiable_password_file", "false"),
+					resource.TestCheckResourceAttr(resourceName, "modifiable_group_file", "false"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccFileProviderResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "modifiable_password_file", "true"),
+					resource.TestCheckResourceAttr(resourceName, "modifiable_group_file", "true"),
+					resource.TestCheckResourceAttr(resourceName, "login_shell", "/bin/sh"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccFileProviderResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateFileProvider).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccFileProviderResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetFileProviderState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileProviderResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccFileProviderResourceConfig = `
+resource "powerscale_file_provider" "test" {
+	name = "tfacc_file_provider"
+	modifiable_password_file = false
+	modifiable_group_file = false
+}
+`
+
+var testAccFileProviderResourceUpdateConfig = `
+resource "powerscale_file_provider" "test" {
+	name = "tfacc_file_provider"
+	modifiable_password_file = true
+	modifiable_group_file = true
+	login_shell = "/bin/sh"
+}
+`
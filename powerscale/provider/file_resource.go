@@ -0,0 +1,394 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &FileResource{}
+	_ resource.ResourceWithConfigure   = &FileResource{}
+	_ resource.ResourceWithImportState = &FileResource{}
+	_ resource.ResourceWithModifyPlan  = &FileResource{}
+)
+
+// NewFileResource creates a new resource.
+func NewFileResource() resource.Resource {
+	return &FileResource{}
+}
+
+// FileResource defines the resource implementation.
+type FileResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *FileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+// Schema describes the resource arguments.
+func (r *FileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage a small file on PowerScale Array. " +
+			"The content of the file is given inline, base64 encoded, or read from a local file, and is written in a single request. " +
+			"We can Create, Update and Delete the files using this resource. We can also import an existing file from PowerScale array.",
+		Description: "This resource is used to manage a small file on PowerScale Array. " +
+			"The content of the file is given inline, base64 encoded, or read from a local file, and is written in a single request. " +
+			"We can Create, Update and Delete the files using this resource. We can also import an existing file from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The path of the file.",
+				MarkdownDescription: "The path of the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				Description:         "The absolute path of the file, for example /ifs/data/file.txt.",
+				MarkdownDescription: "The absolute path of the file, for example /ifs/data/file.txt.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs/.*[^/]$`), "must be an absolute path under /ifs"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The content of the file, as a UTF-8 string. Exactly one of content, content_base64 and source must be set.",
+				MarkdownDescription: "The content of the file, as a UTF-8 string. Exactly one of `content`, `content_base64` and `source` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content_base64"), path.MatchRoot("source")),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The content of the file, base64 encoded. Used for binary content.",
+				MarkdownDescription: "The content of the file, base64 encoded. Used for binary content.",
+			},
+			"source": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path of a local file whose content is uploaded.",
+				MarkdownDescription: "The path of a local file whose content is uploaded.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The SHA-256 checksum of the content of the file. A change of the file outside of terraform is detected through this checksum.",
+				MarkdownDescription: "The SHA-256 checksum of the content of the file. A change of the file outside of terraform is detected through this checksum.",
+			},
+			"overwrite": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to overwrite a file which already exists at the path when creating the resource.",
+				MarkdownDescription: "Whether to overwrite a file which already exists at the path when creating the resource.",
+			},
+			"access_control": schema.StringAttribute{
+				Optional: true,
+				Description: "The access control of the file, either a POSIX mode such as 0644, or one of private_read, private, public_read, public_read_write and public when creating the file. " +
+					"Only a POSIX mode can be set once the file is created.",
+				MarkdownDescription: "The access control of the file, either a POSIX mode such as `0644`, or one of `private_read`, `private`, `public_read`, `public_read_write` and `public` when creating the file. " +
+					"Only a POSIX mode can be set once the file is created.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([0-7]{4}|private_read|private|public_read|public_read_write|public)$`),
+						"must be a POSIX mode of 4 octal digits or a predefined access control",
+					),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the user owning the file.",
+				MarkdownDescription: "The name of the user owning the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the group of the file.",
+				MarkdownDescription: "The name of the group of the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The POSIX mode of the file.",
+				MarkdownDescription: "The POSIX mode of the file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The size of the file in bytes.",
+				MarkdownDescription: "The size of the file in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The time the file was last modified.",
+				MarkdownDescription: "The time the file was last modified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *FileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ModifyPlan computes the checksum and the size of the planned content, so that a change of the content is planned as an update.
+func (r *FileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state models.FileResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Content.IsUnknown() || plan.ContentBase64.IsUnknown() || plan.Source.IsUnknown() {
+		plan.ContentSha256 = types.StringUnknown()
+		plan.Size = types.Int64Unknown()
+		plan.LastModified = types.StringUnknown()
+	} else {
+		content, err := helper.GetPlannedFileContent(&plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading the content of the file", err.Error())
+			return
+		}
+		plan.ContentSha256 = types.StringValue(helper.FileContentSha256(content))
+		plan.Size = types.Int64Value(int64(len(content)))
+		if !plan.ContentSha256.Equal(state.ContentSha256) {
+			plan.LastModified = types.StringUnknown()
+		}
+	}
+
+	if !plan.AccessControl.IsNull() && !plan.AccessControl.Equal(state.AccessControl) {
+		if regexp.MustCompile(`^[0-7]{4}$`).MatchString(plan.AccessControl.ValueString()) {
+			plan.Mode = plan.AccessControl
+		} else {
+			plan.Mode = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create writes the file.
+func (r *FileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating file")
+	var plan models.FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := helper.GetPlannedFileContent(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating file", err.Error())
+		return
+	}
+	if err := helper.WriteFile(ctx, r.client, &plan, content, plan.Overwrite.ValueBool()); err != nil {
+		errStr := constants.CreateFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating file", message)
+		return
+	}
+
+	// the access control is given when the file is written, only the owner and the group are left to set
+	created := models.FileResourceModel{AccessControl: plan.AccessControl}
+	if err := helper.UpdateFileOwnership(ctx, r.client, &plan, &created); err != nil {
+		errStr := constants.CreateFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error setting the owner and group of the file", message)
+		return
+	}
+
+	state := plan
+	found, err := helper.GetFileState(ctx, r.client, &state)
+	if err == nil && !found {
+		err = fmt.Errorf("could not find file %s", plan.Path.ValueString())
+	}
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create file completed")
+}
+
+// Read reads the resource state.
+func (r *FileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading file")
+	var state models.FileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := helper.GetFileState(ctx, r.client, &state)
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file", message)
+		return
+	}
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("File %s no longer exists, removing it from the state", state.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read file completed")
+}
+
+// Update rewrites the content of the file when it changed, and updates its owner, group and mode.
+func (r *FileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating file")
+	var plan, state models.FileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := helper.GetPlannedFileContent(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating file", err.Error())
+		return
+	}
+	if helper.FileContentSha256(content) != state.ContentSha256.ValueString() {
+		if err := helper.WriteFile(ctx, r.client, &plan, content, true); err != nil {
+			errStr := constants.UpdateFileErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error updating file", message)
+			return
+		}
+	}
+
+	if err := helper.UpdateFileOwnership(ctx, r.client, &plan, &state); err != nil {
+		errStr := constants.UpdateFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating file", message)
+		return
+	}
+
+	state = plan
+	found, err := helper.GetFileState(ctx, r.client, &state)
+	if err == nil && !found {
+		err = fmt.Errorf("could not find file %s", plan.Path.ValueString())
+	}
+	if err != nil {
+		errStr := constants.ReadFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Update file completed")
+}
+
+// Delete deletes the file.
+func (r *FileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting file")
+	var state models.FileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteFile(ctx, r.client, state.Path.ValueString()); err != nil {
+		errStr := constants.DeleteFileErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting file", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete file completed")
+}
+
+// ImportState imports the resource state, the ID being the path of the file.
+func (r *FileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite"), false)...)
+}
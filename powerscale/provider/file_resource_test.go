@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFileResource tests the file resource.
+func TestAccFileResource(t *testing.T) {
+	resourceName := "powerscale_file.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid path
+			{
+				Config:      ProviderConfig + testAccFileResourceInvalidPathConfig,
+				ExpectError: regexp.MustCompile(`.*must be an absolute path under /ifs.*`),
+			},
+			// both content and content_base64
+			{
+				Config:      ProviderConfig + testAccFileResourceConflictConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccFileResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.WriteFile).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/ifs/tfacc_file.txt"),
+					resource.TestCheckResourceAttr(resourceName, "size", "12"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "0640"),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "access_control"},
+			},
+			// update content and mode
+			{
+				Config: ProviderConfig + testAccFileResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "size", "5"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "0600"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64", "aGVsbG8="),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccFileResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.WriteFile).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccFileResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetFileState).Return(false, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccFileResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteFile).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccFileResourceConfig = `
+resource "powerscale_file" "test" {
+	path = "/ifs/tfacc_file.txt"
+	content = "hello world\n"
+	access_control = "0640"
+	overwrite = true
+}
+`
+
+var testAccFileResourceUpdateConfig = `
+resource "powerscale_file" "test" {
+	path = "/ifs/tfacc_file.txt"
+	content_base64 = "aGVsbG8="
+	access_control = "0600"
+	overwrite = true
+}
+`
+
+var testAccFileResourceInvalidPathConfig = `
+resource "powerscale_file" "test" {
+	path = "tfacc_file.txt"
+	content = "hello world\n"
+}
+`
+
+var testAccFileResourceConflictConfig = `
+resource "powerscale_file" "test" {
+	path = "/ifs/tfacc_file.txt"
+	content = "hello world\n"
+	content_base64 = "aGVsbG8="
+}
+`
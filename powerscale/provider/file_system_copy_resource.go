@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &FileSystemCopyResource{}
+	_ resource.ResourceWithConfigure      = &FileSystemCopyResource{}
+	_ resource.ResourceWithValidateConfig = &FileSystemCopyResource{}
+	_ resource.ResourceWithModifyPlan     = &FileSystemCopyResource{}
+)
+
+// NewFileSystemCopyResource creates a new resource.
+func NewFileSystemCopyResource() resource.Resource {
+	return &FileSystemCopyResource{}
+}
+
+// FileSystemCopyResource defines the resource implementation.
+type FileSystemCopyResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *FileSystemCopyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_system_copy"
+}
+
+// fileSystemCopyPathValidators validates the source and target paths of the copy.
+var fileSystemCopyPathValidators = []validator.String{
+	stringvalidator.RegexMatches(regexp.MustCompile(`^/ifs/.*[^/]$`), "must be an absolute path under /ifs"),
+}
+
+// Schema describes the resource arguments.
+func (r *FileSystemCopyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to copy a directory or a file on PowerScale Array. " +
+			"The copy is made once when the resource is created, and is made again when any of the arguments changes. " +
+			"Destroying the resource only removes it from the state, the copy is left on the PowerScale array. " +
+			"As the previous copy is left on the target, changing the arguments while keeping `target_path` requires `overwrite`, or `merge` for a directory.",
+		Description: "This resource is used to copy a directory or a file on PowerScale Array. " +
+			"The copy is made once when the resource is created, and is made again when any of the arguments changes. " +
+			"Destroying the resource only removes it from the state, the copy is left on the PowerScale array. " +
+			"As the previous copy is left on the target, changing the arguments while keeping target_path requires overwrite, or merge for a directory.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The path of the copy.",
+				MarkdownDescription: "The path of the copy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				Required:            true,
+				Description:         "The absolute path of the directory or file to copy, for example /ifs/data/source.",
+				MarkdownDescription: "The absolute path of the directory or file to copy, for example /ifs/data/source.",
+				Validators:          fileSystemCopyPathValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_path": schema.StringAttribute{
+				Required:            true,
+				Description:         "The absolute path of the copy, for example /ifs/data/target.",
+				MarkdownDescription: "The absolute path of the copy, for example /ifs/data/target.",
+				Validators:          fileSystemCopyPathValidators,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"merge": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to merge the source directory into an existing target directory. Only supported when copying a directory.",
+				MarkdownDescription: "Whether to merge the source directory into an existing target directory. Only supported when copying a directory.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to overwrite the existing files of the target.",
+				MarkdownDescription: "Whether to overwrite the existing files of the target.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"continue_on_error": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to continue copying the remaining files after a file could not be copied. Only supported when copying a directory.",
+				MarkdownDescription: "Whether to continue copying the remaining files after a file could not be copied. Only supported when copying a directory.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"clone": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to clone the source file, sharing its blocks, instead of copying its data. Only supported when copying a file.",
+				MarkdownDescription: "Whether to clone the source file, sharing its blocks, instead of copying its data. Only supported when copying a file.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the snapshot to clone the source file from. Only supported when clone is true.",
+				MarkdownDescription: "The name of the snapshot to clone the source file from. Only supported when `clone` is true.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The type of the copy, container for a directory and object for a file.",
+				MarkdownDescription: "The type of the copy, container for a directory and object for a file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *FileSystemCopyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ValidateConfig checks that a snapshot is only set when the source file is cloned.
+func (r *FileSystemCopyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.FileSystemCopyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Snapshot.IsNull() || config.Snapshot.IsUnknown() || config.Clone.IsUnknown() {
+		return
+	}
+	if !config.Clone.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("snapshot"), "Invalid file system copy snapshot", "snapshot is only supported when clone is true")
+	}
+}
+
+// ModifyPlan rejects copying the source again onto the previous copy, unless the copy may overwrite or merge into it.
+func (r *FileSystemCopyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state models.FileSystemCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TargetPath.Equal(state.TargetPath) || plan.Merge.ValueBool() || plan.Overwrite.ValueBool() {
+		return
+	}
+	// any known change of the arguments replaces the copy, while the previous copy is left on the target
+	replaced := (!plan.SourcePath.IsUnknown() && !plan.SourcePath.Equal(state.SourcePath)) ||
+		(!plan.Merge.IsUnknown() && !plan.Merge.Equal(state.Merge)) ||
+		(!plan.Overwrite.IsUnknown() && !plan.Overwrite.Equal(state.Overwrite)) ||
+		(!plan.ContinueOnError.IsUnknown() && !plan.ContinueOnError.Equal(state.ContinueOnError)) ||
+		(!plan.Clone.IsUnknown() && !plan.Clone.Equal(state.Clone)) ||
+		(!plan.Snapshot.IsUnknown() && !plan.Snapshot.Equal(state.Snapshot))
+	if replaced {
+		resp.Diagnostics.AddAttributeError(path.Root("target_path"), "File system copy target already exists",
+			fmt.Sprintf("Changing the arguments copies the source again onto %s, which still holds the previous copy. "+
+				"Set overwrite, or merge for a directory, remove the previous copy, or change target_path.", state.TargetPath.ValueString()))
+	}
+}
+
+// Create copies the source to the target.
+func (r *FileSystemCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Copying file system")
+	var plan models.FileSystemCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := helper.CopyFileSystem(ctx, r.client, &plan); err != nil {
+		errStr := constants.CopyFileSystemErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error copying file system", message)
+		return
+	}
+
+	state := plan
+	found, err := helper.GetFileSystemCopyState(ctx, r.client, &state)
+	if err == nil && !found {
+		err = fmt.Errorf("could not find copy %s", plan.TargetPath.ValueString())
+	}
+	if err != nil {
+		errStr := constants.ReadFileSystemCopyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file system copy after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Copy file system completed")
+}
+
+// Read reads the resource state.
+func (r *FileSystemCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading file system copy")
+	var state models.FileSystemCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := helper.GetFileSystemCopyState(ctx, r.client, &state)
+	if err != nil {
+		errStr := constants.ReadFileSystemCopyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading file system copy", message)
+		return
+	}
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("Copy %s no longer exists, removing it from the state", state.TargetPath.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read file system copy completed")
+}
+
+// Update is never called, as any change of the arguments replaces the copy.
+func (r *FileSystemCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state models.FileSystemCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the copy from the state, the copy is left on the cluster.
+func (r *FileSystemCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing file system copy from the state")
+	resp.State.RemoveResource(ctx)
+}
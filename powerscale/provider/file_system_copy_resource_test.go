@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFileSystemCopyResource tests the file system copy resource.
+func TestAccFileSystemCopyResource(t *testing.T) {
+	resourceName := "powerscale_file_system_copy.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid path
+			{
+				Config:      ProviderConfig + testAccFileSystemCopyResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*must be an absolute path under /ifs.*`),
+			},
+			// clone a directory
+			{
+				Config:      ProviderConfig + testAccFileSystemCopyResourceCloneDirConfig,
+				ExpectError: regexp.MustCompile(`.*only supported when copying a file.*`),
+			},
+			// snapshot without clone
+			{
+				Config:      ProviderConfig + testAccFileSystemCopyResourceSnapshotConfig,
+				ExpectError: regexp.MustCompile(`.*snapshot is only supported when clone is true.*`),
+			},
+			// copy error
+			{
+				Config: ProviderConfig + testAccFileSystemCopyResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CopyFileSystem).Return("", fmt.Errorf("mock copy error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock copy error.*`),
+			},
+			// copy a directory
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileSystemCopyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/ifs/tfaccCopyTarget"),
+					resource.TestCheckResourceAttr(resourceName, "type", "container"),
+					resource.TestCheckResourceAttr("powerscale_file_system_copy.file", "type", "object"),
+				),
+			},
+			// copy again onto the previous copy without overwrite
+			{
+				Config:      ProviderConfig + testAccFileSystemCopyResourceRecopyConfig,
+				ExpectError: regexp.MustCompile(`.*still holds the previous copy.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccFileSystemCopyResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetFileSystemCopyState).Return(false, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccFileSystemCopyResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccFileSystemCopyResourceSource = `
+resource "powerscale_filesystem" "source" {
+	name = "tfaccCopySource"
+	delete_mode = "recursive"
+}
+
+resource "powerscale_file" "source" {
+	path = "/ifs/tfaccCopySource/file.txt"
+	content = "hello world\n"
+	depends_on = [powerscale_filesystem.source]
+}
+`
+
+var testAccFileSystemCopyResourceConfig = testAccFileSystemCopyResourceSource + `
+resource "powerscale_filesystem" "target" {
+	name = "tfaccCopyTarget"
+	delete_mode = "recursive"
+}
+
+resource "powerscale_file_system_copy" "test" {
+	source_path = "/ifs/tfaccCopySource"
+	target_path = powerscale_filesystem.target.full_path
+	merge = true
+	overwrite = true
+	depends_on = [powerscale_file.source]
+}
+
+resource "powerscale_file_system_copy" "file" {
+	source_path = powerscale_file.source.path
+	target_path = "/ifs/tfaccCopySource/file_clone.txt"
+	clone = true
+}
+`
+
+var testAccFileSystemCopyResourceCloneDirConfig = testAccFileSystemCopyResourceSource + `
+resource "powerscale_file_system_copy" "test" {
+	source_path = "/ifs/tfaccCopySource"
+	target_path = "/ifs/tfaccCopyTarget"
+	clone = true
+	depends_on = [powerscale_file.source]
+}
+`
+
+var testAccFileSystemCopyResourceInvalidConfig = `
+resource "powerscale_file_system_copy" "test" {
+	source_path = "tfaccCopySource"
+	target_path = "/ifs/tfaccCopyTarget"
+}
+`
+
+var testAccFileSystemCopyResourceSnapshotConfig = `
+resource "powerscale_file_system_copy" "test" {
+	source_path = "/ifs/tfaccCopySource/file.txt"
+	target_path = "/ifs/tfaccCopySource/file_clone.txt"
+	clone = false
+	snapshot = "tfacc_snapshot"
+}
+`
+
+var testAccFileSystemCopyResourceRecopyConfig = testAccFileSystemCopyResourceSource + `
+resource "powerscale_filesystem" "target" {
+	name = "tfaccCopyTarget"
+	delete_mode = "recursive"
+}
+
+resource "powerscale_file_system_copy" "test" {
+	source_path = "/ifs/tfaccCopySource"
+	target_path = powerscale_filesystem.target.full_path
+	merge = true
+	overwrite = true
+	depends_on = [powerscale_file.source]
+}
+
+resource "powerscale_file_system_copy" "file" {
+	source_path = powerscale_file.source.path
+	target_path = "/ifs/tfaccCopySource/file_clone.txt"
+	clone = false
+}
+`
@@ -21,12 +21,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"terraform-provider-powerscale/client"
 
 	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -71,8 +73,8 @@ func (r *FileSystemResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:            true,
 			},
 			"name": schema.StringAttribute{
-				Description:         "FileSystem directory name",
-				MarkdownDescription: "FileSystem directory name",
+				Description:         "FileSystem directory name. (Update Supported, the directory is moved in place along with its content)",
+				MarkdownDescription: "FileSystem directory name. (Update Supported, the directory is moved in place along with its content)",
 				Required:            true,
 			},
 			"full_path": schema.StringAttribute{
@@ -82,8 +84,8 @@ func (r *FileSystemResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:            true,
 			},
 			"directory_path": schema.StringAttribute{
-				Description:         "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default.",
-				MarkdownDescription: "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default.",
+				Description:         "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default. (Update Supported, the directory is moved in place along with its content)",
+				MarkdownDescription: "FileSystem directory path.This specifies the path to the FileSystem(Namespace directory) which we are trying to manage. If no directory path is specified, [/ifs] would be taken by default. (Update Supported, the directory is moved in place along with its content)",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("/ifs"),
@@ -208,6 +210,61 @@ func (r *FileSystemResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Acl mode",
 				Computed:            true,
 			},
+			"requested_protection": schema.StringAttribute{
+				Description:         "The requested protection of the directory, such as default, +2:1, +3d:1n or 3x. (Update Supported)",
+				MarkdownDescription: "The requested protection of the directory, such as `default`, `+2:1`, `+3d:1n` or `3x`. (Update Supported)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{stringvalidator.RegexMatches(
+					regexp.MustCompile(`^(default|\+[1-4](:[1-4]|d:[1-4]n)?|[2-8]x)$`), "must be default, a FEC protection such as +2:1 or +3d:1n, or a mirroring such as 3x",
+				)},
+			},
+			"access_pattern": schema.StringAttribute{
+				Description:         "The access pattern of the directory, used to optimize the layout and prefetch of its files. (Update Supported)",
+				MarkdownDescription: "The access pattern of the directory, used to optimize the layout and prefetch of its files. (Update Supported)",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("default", "streaming", "random")},
+			},
+			"ssd_strategy": schema.StringAttribute{
+				Description:         "The SSD strategy of the directory. (Update Supported)",
+				MarkdownDescription: "The SSD strategy of the directory. (Update Supported)",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("metadata", "metadata-write", "data", "avoid")},
+			},
+			"disk_pool_policy": schema.StringAttribute{
+				Description:         "The SmartPools tier or node pool the data of the directory is written to, or anywhere. (Update Supported)",
+				MarkdownDescription: "The SmartPools tier or node pool the data of the directory is written to, or `anywhere`. (Update Supported)",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"delete_mode": schema.StringAttribute{
+				Description: "What to do with the directory when the resource is destroyed. fail_if_not_empty deletes the directory only when it is empty, " +
+					"recursive deletes the directory along with its content, and retain leaves the directory on the cluster. " +
+					"The directory is not deleted while a quota, SMB share, NFS export or SyncIQ policy is set on it or on one of its subdirectories.",
+				MarkdownDescription: "What to do with the directory when the resource is destroyed. `fail_if_not_empty` deletes the directory only when it is empty, " +
+					"`recursive` deletes the directory along with its content, and `retain` leaves the directory on the cluster. " +
+					"The directory is not deleted while a quota, SMB share, NFS export or SyncIQ policy is set on it or on one of its subdirectories.",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(helper.FileSystemDeleteModeFailIfNotEmpty),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						helper.FileSystemDeleteModeFailIfNotEmpty,
+						helper.FileSystemDeleteModeRecursive,
+						helper.FileSystemDeleteModeRetain,
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -244,6 +301,15 @@ func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	dirPath := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
 
 	createReq := r.client.PscaleOpenAPIClient.NamespaceApi.CreateDirectory(ctx, dirPath)
@@ -267,6 +333,15 @@ func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddWarning(fmt.Sprintf("Error setting the File system Resource - %s", dirPath), err.Error())
 	}
 
+	if err := helper.UpdateFileSystemAttributes(ctx, r.client, dirPath, &plan, &models.FileSystemResource{}); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error setting the attributes of the File system Resource - %s", dirPath), err.Error())
+		// if err, revert create
+		if err = helper.DeleteFileSystem(ctx, r.client, dirPath, false); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error deleting filesystem when reverting creation - %s", err.Error()))
+		}
+		return
+	}
+
 	// Get File system metadata
 	meta, err := helper.GetDirectoryMetadata(ctx, r.client, dirPath)
 	if err != nil {
@@ -274,7 +349,7 @@ func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 		message := helper.GetErrorString(err, errStr)
 		resp.Diagnostics.AddError("Error getting the metadata for the filesystem", message)
 		// if err, revert create
-		if err = helper.DeleteFileSystem(ctx, r.client, dirPath); err != nil {
+		if err = helper.DeleteFileSystem(ctx, r.client, dirPath, false); err != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error deleting filesystem when reverting creation - %s", err.Error()))
 		}
 		return
@@ -287,7 +362,7 @@ func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 		message := helper.GetErrorString(err, errStr)
 		resp.Diagnostics.AddError("Error getting the acl for the filesystem", message)
 		// if err, revert create
-		if err = helper.DeleteFileSystem(ctx, r.client, dirPath); err != nil {
+		if err = helper.DeleteFileSystem(ctx, r.client, dirPath, false); err != nil {
 			tflog.Error(ctx, fmt.Sprintf("Error deleting filesystem when reverting creation - %s", err.Error()))
 		}
 		return
@@ -351,8 +426,50 @@ func (r *FileSystemResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	dirPath := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
-	if err := helper.DeleteFileSystem(ctx, r.client, dirPath); err != nil {
+	deleteMode := plan.DeleteMode.ValueString()
+	if deleteMode == helper.FileSystemDeleteModeRetain {
+		tflog.Info(ctx, fmt.Sprintf("Retaining the directory %s on the cluster, only removing it from the state", dirPath))
+		return
+	}
+
+	blockers, err := helper.GetFileSystemDeleteBlockers(ctx, r.client, dirPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting filesystem", err.Error())
+		return
+	}
+	if len(blockers) > 0 {
+		resp.Diagnostics.AddError("Error Deleting filesystem",
+			fmt.Sprintf("The directory /%s is still in use, remove the following first or set delete_mode to retain: %s", dirPath, strings.Join(blockers, "; ")))
+		return
+	}
+
+	recursive := deleteMode == helper.FileSystemDeleteModeRecursive
+	if !recursive {
+		empty, err := helper.IsDirectoryEmpty(ctx, r.client, dirPath)
+		if err != nil {
+			errStr := constants.DeleteFileSystemErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error Deleting filesystem", message)
+			return
+		}
+		if !empty {
+			resp.Diagnostics.AddError("Error Deleting filesystem",
+				fmt.Sprintf("The directory /%s is not empty, set delete_mode to recursive to delete its content or to retain to leave it on the cluster", dirPath))
+			return
+		}
+	}
+
+	if err := helper.DeleteFileSystem(ctx, r.client, dirPath, recursive); err != nil {
 		resp.Diagnostics.AddError("Error Deleting filesystem", err.Error())
 		return
 	}
@@ -376,11 +493,22 @@ func (r *FileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	planDirName := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
 	stateDirName := helper.GetDirectoryPath(state.DirectoryPath.ValueString(), state.Name.ValueString())
 	if planDirName != stateDirName {
-		resp.Diagnostics.AddError(constants.UpdateFileSystemErrorMsg, "Renaming Directory is not supported")
-		return
+		// the directory is moved in place, so its content is kept
+		if err := helper.MoveFileSystem(ctx, r.client, stateDirName, planDirName); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error moving the File system Resource - %s", stateDirName), err.Error())
+			return
+		}
 	}
 
 	if err := helper.UpdateFileSystemOwnerAndGroup(ctx, r.client, planDirName, &plan, &state); err != nil {
@@ -392,6 +520,11 @@ func (r *FileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if err := helper.UpdateFileSystemAttributes(ctx, r.client, planDirName, &plan, &state); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating the File system Resource - %s", planDirName), err.Error())
+		return
+	}
+
 	// Get metadata
 	meta, err := helper.GetDirectoryMetadata(ctx, r.client, planDirName)
 	if err != nil {
@@ -445,6 +578,7 @@ func (r *FileSystemResource) ImportState(ctx context.Context, req resource.Impor
 
 	// copy to model
 	helper.UpdateFileSystemResourceImportState(ctx, id, &state, acl, meta)
+	state.Timeouts = helper.NullTimeouts()
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -362,7 +362,7 @@ func TestFileSystemResourceUpdateUserErr(t *testing.T) {
 	})
 }
 func TestFileSystemResourceUpdateFail(t *testing.T) {
-
+	var fileSystemResourceName = "powerscale_filesystem.file_system_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -385,10 +385,47 @@ func TestFileSystemResourceUpdateFail(t *testing.T) {
 				},
 				Config: ProviderConfig + FileSystemResourceUpdConfig,
 			},
-			//Update owner/group/accessControl, then Read testing
+			// Rename error
 			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.MoveFileSystem).Return(fmt.Errorf("mock move error")).Build()
+				},
 				Config:      ProviderConfig + FileSystemUpdateResourceConfigErr,
-				ExpectError: regexp.MustCompile(`.*Renaming Directory is not supported*.`),
+				ExpectError: regexp.MustCompile(`.*mock move error*.`),
+			},
+			// Rename in place
+			{
+				PreConfig: func() {
+					FunctionMocker.UnPatch()
+				},
+				Config: ProviderConfig + FileSystemUpdateResourceConfigErr,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "name", "tfaccDirTfUpdErr"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "id", "ifs/tfaccDirTfUpdErr"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileSystemResourceTimeouts(t *testing.T) {
+	var fileSystemResourceName = "powerscale_filesystem.file_system_test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid timeout
+			{
+				Config:      ProviderConfig + FileSystemResourceTimeoutsConfig("invalid"),
+				ExpectError: regexp.MustCompile(`.*must be a string containing a sequence of decimal numbers.*`),
+			},
+			// create with timeouts
+			{
+				Config: ProviderConfig + FileSystemResourceTimeoutsConfig("30m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "timeouts.create", "30m"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "timeouts.delete", "30m"),
+				),
 			},
 		},
 	})
@@ -499,6 +536,130 @@ func TestAccFileSystemResourceDeleteMockErr(t *testing.T) {
 	})
 }
 
+func TestAccFileSystemResourceDeleteMode(t *testing.T) {
+	var fileSystemResourceName = "powerscale_filesystem.file_system_delete_mode"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid delete mode
+			{
+				Config:      ProviderConfig + FileSystemResourceDeleteModeInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Attribute delete_mode value must be one of.*`),
+			},
+			// create with the default delete mode
+			{
+				Config: ProviderConfig + FileSystemResourceDeleteModeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "delete_mode", "fail_if_not_empty"),
+				),
+			},
+			// destroy a directory which is not empty
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.IsDirectoryEmpty).Return(false, nil).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceDeleteModeConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*is not empty.*`),
+			},
+			// destroy a directory which is still shared
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetFileSystemDeleteBlockers).Return([]string{"SMB share tfacc_share (zone System) on /ifs/tfaccDirDeleteMode"}, nil).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceDeleteModeConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*SMB share tfacc_share.*`),
+			},
+			// update to recursive
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileSystemResourceDeleteModeRecursiveConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "delete_mode", "recursive"),
+				),
+			},
+			// destroy a directory which is the target of a local SyncIQ policy
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetAllSyncIQPolicies).Return(&powerscale.V14SyncPolicies{
+						Policies: []powerscale.V14SyncPolicyExtended{
+							{Name: "tfacc_local_policy", SourceRootPath: "/ifs/tfaccLocalSource", TargetHost: "localhost", TargetPath: "/ifs/tfaccDirDeleteMode/replica"},
+						},
+					}, nil).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceDeleteModeRecursiveConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*SyncIQ policy tfacc_local_policy targeting /ifs/tfaccDirDeleteMode/replica.*`),
+			},
+			// destroy a directory which is the target of a SyncIQ policy of another cluster
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.ListSyncIQTargetPolicies).Return([]powerscale.V14TargetPolicy{
+						{Id: "tfacc_target_policy_id", Name: "tfacc_target_policy", TargetPath: "/ifs/tfaccDirDeleteMode"},
+					}, nil).Build()
+				},
+				Config:      ProviderConfig + FileSystemResourceDeleteModeRecursiveConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*SyncIQ target policy tfacc_target_policy targeting /ifs/tfaccDirDeleteMode.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + FileSystemResourceDeleteModeRecursiveConfig,
+			},
+		},
+	})
+}
+
+func TestAccFileSystemResourceAttributes(t *testing.T) {
+	var fileSystemResourceName = "powerscale_filesystem.file_system_attributes"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid protection
+			{
+				Config:      ProviderConfig + fmt.Sprintf(FileSystemResourceAttributesConfig, "+9:1", "streaming"),
+				ExpectError: regexp.MustCompile(`.*must be default, a FEC protection.*`),
+			},
+			// set attributes error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateFileSystemAttributes).Return(fmt.Errorf("mock attributes error")).Build()
+				},
+				Config:      ProviderConfig + fmt.Sprintf(FileSystemResourceAttributesConfig, "+2:1", "streaming"),
+				ExpectError: regexp.MustCompile(`.*mock attributes error.*`),
+			},
+			// create with attributes
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + fmt.Sprintf(FileSystemResourceAttributesConfig, "+2:1", "streaming"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "requested_protection", "+2:1"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "access_pattern", "streaming"),
+				),
+			},
+			// update attributes
+			{
+				Config: ProviderConfig + fmt.Sprintf(FileSystemResourceAttributesConfig, "default", "random"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fileSystemResourceName, "requested_protection", "default"),
+					resource.TestCheckResourceAttr(fileSystemResourceName, "access_pattern", "random"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFileSystemResourceReleaseMock(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -698,3 +859,62 @@ resource "powerscale_filesystem" "file_system_test" {
 	}
   }
 `
+
+func FileSystemResourceTimeoutsConfig(timeout string) string {
+	return fmt.Sprintf(`
+resource "powerscale_filesystem" "file_system_test" {
+	name = "tfaccDirTimeouts"
+	recursive = true
+	overwrite = false
+	group = {
+	  id   = "GID:0"
+	  name = "wheel"
+	  type = "group"
+	}
+	owner = {
+	  id   = "UID:0",
+	  name = "root",
+	  type = "user"
+	}
+	timeouts {
+	  create = "%s"
+	  update = "%s"
+	  delete = "%s"
+	}
+}
+`, timeout, timeout, timeout)
+}
+
+var FileSystemResourceDeleteModeConfig = `
+resource "powerscale_filesystem" "file_system_delete_mode" {
+	name = "tfaccDirDeleteMode"
+}
+`
+
+var FileSystemResourceDeleteModeRecursiveConfig = `
+resource "powerscale_filesystem" "file_system_delete_mode" {
+	name = "tfaccDirDeleteMode"
+	delete_mode = "recursive"
+}
+
+resource "powerscale_file" "file_delete_mode" {
+	path = "/ifs/tfaccDirDeleteMode/file.txt"
+	content = "hello world\n"
+	depends_on = [powerscale_filesystem.file_system_delete_mode]
+}
+`
+
+var FileSystemResourceDeleteModeInvalidConfig = `
+resource "powerscale_filesystem" "file_system_delete_mode" {
+	name = "tfaccDirDeleteMode"
+	delete_mode = "invalid"
+}
+`
+
+var FileSystemResourceAttributesConfig = `
+resource "powerscale_filesystem" "file_system_attributes" {
+	name = "tfaccDirAttributes"
+	requested_protection = "%s"
+	access_pattern = "%s"
+}
+`
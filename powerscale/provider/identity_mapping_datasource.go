@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &IdentityMappingDataSource{}
+	_ datasource.DataSourceWithConfigure = &IdentityMappingDataSource{}
+)
+
+// NewIdentityMappingDataSource creates a new data source.
+func NewIdentityMappingDataSource() datasource.DataSource {
+	return &IdentityMappingDataSource{}
+}
+
+// IdentityMappingDataSource defines the data source implementation.
+type IdentityMappingDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *IdentityMappingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_mapping"
+}
+
+// Schema describes the data source arguments.
+func (d *IdentityMappingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to look up the identity mappings of a source persona, such as a SID, UID or GID, on PowerScale array. Looking up a persona does not allocate a new mapping for it.",
+		Description:         "This datasource is used to look up the identity mappings of a source persona, such as a SID, UID or GID, on PowerScale array. Looking up a persona does not allocate a new mapping for it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the identity mapping data source.",
+				MarkdownDescription: "Identifier of the identity mapping data source.",
+			},
+			"source": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the source persona to look up, e.g. SID:S-1-5-21-1-2-3-1001, UID:2001 or GID:2001.",
+				MarkdownDescription: "Specifies the source persona to look up, e.g. `SID:S-1-5-21-1-2-3-1001`, `UID:2001` or `GID:2001`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(personaRegex, "must be a persona of the form SID:<sid>, UID:<uid> or GID:<gid>"),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Specifies the access zone of the mapping. If not set, the System zone is used.",
+				MarkdownDescription: "Specifies the access zone of the mapping. If not set, the System zone is used.",
+			},
+			"targets": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of the targets the source persona is mapped to.",
+				MarkdownDescription: "List of the targets the source persona is mapped to.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"target": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the target persona.",
+							MarkdownDescription: "Specifies the target persona.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the name of the target persona.",
+							MarkdownDescription: "Specifies the name of the target persona.",
+						},
+						"on_disk": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the target identity is stored on disk.",
+							MarkdownDescription: "Whether the target identity is stored on disk.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the type of the mapping.",
+							MarkdownDescription: "Specifies the type of the mapping.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *IdentityMappingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *IdentityMappingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.IdentityMappingDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := helper.GetIdentityMappings(ctx, d.client, data.Source.ValueString(), data.Zone.ValueString())
+	if err != nil {
+		errStr := constants.ReadIdentityMappingErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading identity mapping", message)
+		return
+	}
+
+	state := helper.NewIdentityMappingDataSource(result, data.Source, data.Zone)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
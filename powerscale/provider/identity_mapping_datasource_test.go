@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccIdentityMappingDataSource tests the identity mapping data source.
+func TestAccIdentityMappingDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// look up
+			{
+				Config: ProviderConfig + testAccIdentityMappingDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_identity_mapping.test", "id", "identity_mapping_datasource"),
+					resource.TestCheckResourceAttr("data.powerscale_identity_mapping.test", "source", testAccIdentityMappingSource),
+					resource.TestCheckTypeSetElemNestedAttrs("data.powerscale_identity_mapping.test", "targets.*", map[string]string{
+						"target": "UID:200001",
+					}),
+				),
+			},
+			// invalid persona
+			{
+				Config:      ProviderConfig + testAccIdentityMappingDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*must be a persona of the form.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccIdentityMappingDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetIdentityMappings).Return(nil, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccIdentityMappingDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccIdentityMappingDataSourceConfig = testAccIdentityMappingResourceConfig + `
+data "powerscale_identity_mapping" "test" {
+	source = powerscale_identity_mapping.test.source
+	zone = "System"
+}
+`
+
+var testAccIdentityMappingDataSourceInvalidConfig = `
+data "powerscale_identity_mapping" "invalid" {
+	source = "tfacc_user"
+}
+`
@@ -0,0 +1,282 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IdentityMappingResource{}
+	_ resource.ResourceWithConfigure   = &IdentityMappingResource{}
+	_ resource.ResourceWithImportState = &IdentityMappingResource{}
+)
+
+// personaRegex matches the personas accepted by the identity mapping, e.g. SID:S-1-5-21-1-2-3-1001, UID:2001 or GID:2001.
+var personaRegex = regexp.MustCompile(`^(?i)(SID|UID|GID):\S+$`)
+
+// NewIdentityMappingResource creates a new resource.
+func NewIdentityMappingResource() resource.Resource {
+	return &IdentityMappingResource{}
+}
+
+// IdentityMappingResource defines the resource implementation.
+type IdentityMappingResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *IdentityMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_mapping"
+}
+
+// Schema describes the resource arguments.
+func (r *IdentityMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage an explicit identity mapping of PowerScale Array, which pins a source persona such as a SID to a target persona such as a UID or GID. We can Create and Delete the identity mapping using this resource. We can also import an existing identity mapping from PowerScale array. Updating any argument recreates the identity mapping.",
+		Description:         "This resource is used to manage an explicit identity mapping of PowerScale Array, which pins a source persona such as a SID to a target persona such as a UID or GID. We can Create and Delete the identity mapping using this resource. We can also import an existing identity mapping from PowerScale array. Updating any argument recreates the identity mapping.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the identity mapping, in the form [<zone>:]<source>:<target>.",
+				MarkdownDescription: "The ID of the identity mapping, in the form `[<zone>:]<source>:<target>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the source persona, e.g. SID:S-1-5-21-1-2-3-1001, UID:2001 or GID:2001.",
+				MarkdownDescription: "Specifies the source persona, e.g. `SID:S-1-5-21-1-2-3-1001`, `UID:2001` or `GID:2001`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(personaRegex, "must be a persona of the form SID:<sid>, UID:<uid> or GID:<gid>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the target persona, e.g. SID:S-1-5-21-1-2-3-1001, UID:2001 or GID:2001.",
+				MarkdownDescription: "Specifies the target persona, e.g. `SID:S-1-5-21-1-2-3-1001`, `UID:2001` or `GID:2001`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(personaRegex, "must be a persona of the form SID:<sid>, UID:<uid> or GID:<gid>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Specifies the access zone of the mapping. If not set, the System zone is used.",
+				MarkdownDescription: "Specifies the access zone of the mapping. If not set, the System zone is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"two_way": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "If true, the reverse mapping from target to source is created and deleted as well.",
+				MarkdownDescription: "If true, the reverse mapping from target to source is created and deleted as well.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"replace": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "If true, existing mappings of the source are replaced on creation.",
+				MarkdownDescription: "If true, existing mappings of the source are replaced on creation.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"on_disk": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether the target identity is stored on disk.",
+				MarkdownDescription: "Whether the target identity is stored on disk.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the type of the mapping.",
+				MarkdownDescription: "Specifies the type of the mapping.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *IdentityMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *IdentityMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating identity mapping")
+	var plan models.IdentityMappingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.CreateIdentityMapping(ctx, r.client, &plan); err != nil {
+		errStr := constants.CreateIdentityMappingErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating identity mapping", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetIdentityMappingState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadIdentityMappingErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading identity mapping after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create identity mapping completed")
+}
+
+// Read reads the resource state.
+func (r *IdentityMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading identity mapping")
+	var state models.IdentityMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetIdentityMappingState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadIdentityMappingErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading identity mapping", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read identity mapping completed")
+}
+
+// Update updates the resource state.
+// All the arguments require replacement, so only the computed attributes are refreshed here.
+func (r *IdentityMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating identity mapping")
+	var plan models.IdentityMappingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetIdentityMappingState(ctx, r.client, &plan); err != nil {
+		errStr := constants.ReadIdentityMappingErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading identity mapping after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update identity mapping completed")
+}
+
+// Delete deletes the resource.
+func (r *IdentityMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting identity mapping")
+	var state models.IdentityMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteIdentityMapping(ctx, r.client, &state); err != nil {
+		errStr := constants.DeleteIdentityMappingErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting identity mapping", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete identity mapping completed")
+}
+
+// ImportState imports the resource state.
+func (r *IdentityMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing identity mapping")
+	zone, source, target, err := helper.ParseIdentityMappingID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing identity mapping ID", err.Error())
+		return
+	}
+
+	state := models.IdentityMappingResourceModel{
+		Source:  types.StringValue(source),
+		Target:  types.StringValue(target),
+		Zone:    types.StringNull(),
+		TwoWay:  types.BoolValue(false),
+		Replace: types.BoolValue(false),
+	}
+	if zone != "" {
+		state.Zone = types.StringValue(zone)
+	}
+	if err := helper.GetIdentityMappingState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadIdentityMappingErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing identity mapping", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Import identity mapping completed")
+}
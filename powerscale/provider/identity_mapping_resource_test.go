@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccIdentityMappingResource tests the identity mapping resource.
+func TestAccIdentityMappingResource(t *testing.T) {
+	resourceName := "powerscale_identity_mapping.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid persona
+			{
+				Config:      ProviderConfig + testAccIdentityMappingResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*must be a persona of the form.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccIdentityMappingResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateIdentityMapping).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccIdentityMappingResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "System:"+testAccIdentityMappingSource+":UID:200001"),
+					resource.TestCheckResourceAttr(resourceName, "source", testAccIdentityMappingSource),
+					resource.TestCheckResourceAttr(resourceName, "target", "UID:200001"),
+					resource.TestCheckResourceAttr(resourceName, "two_way", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// import with invalid ID
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "UID:200001",
+				ExpectError:   regexp.MustCompile(`.*invalid identity mapping ID.*`),
+			},
+			// replace the target
+			{
+				Config: ProviderConfig + testAccIdentityMappingResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "System:"+testAccIdentityMappingSource+":UID:200002"),
+					resource.TestCheckResourceAttr(resourceName, "target", "UID:200002"),
+					resource.TestCheckResourceAttr(resourceName, "two_way", "true"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccIdentityMappingResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetIdentityMappingState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccIdentityMappingResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteIdentityMapping).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccIdentityMappingResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccIdentityMappingSource = "SID:S-1-5-21-1111111111-2222222222-3333333333-1001"
+
+var testAccIdentityMappingResourceConfig = fmt.Sprintf(`
+resource "powerscale_identity_mapping" "test" {
+	source = "%s"
+	target = "UID:200001"
+	zone = "System"
+}
+`, testAccIdentityMappingSource)
+
+var testAccIdentityMappingResourceUpdateConfig = fmt.Sprintf(`
+resource "powerscale_identity_mapping" "test" {
+	source = "%s"
+	target = "UID:200002"
+	zone = "System"
+	two_way = true
+	replace = true
+}
+`, testAccIdentityMappingSource)
+
+var testAccIdentityMappingResourceInvalidConfig = `
+resource "powerscale_identity_mapping" "test" {
+	source = "tfacc_user"
+	target = "UID:200001"
+}
+`
@@ -0,0 +1,283 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &JobDataSource{}
+	_ datasource.DataSourceWithConfigure = &JobDataSource{}
+)
+
+// NewJobDataSource creates a new data source.
+func NewJobDataSource() datasource.DataSource {
+	return &JobDataSource{}
+}
+
+// JobDataSource defines the data source implementation.
+type JobDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *JobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema describes the data source arguments.
+func (d *JobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the active, that is running and paused, Job Engine jobs on PowerScale Array, and optionally the recently finished jobs recorded in the job events. The information fetched from this datasource can be used for monitoring the progress of the jobs.",
+		Description:         "This datasource is used to query the active, that is running and paused, Job Engine jobs on PowerScale Array, and optionally the recently finished jobs recorded in the job events. The information fetched from this datasource can be used for monitoring the progress of the jobs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"jobs": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of Job Engine jobs.",
+				MarkdownDescription: "List of Job Engine jobs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the job.",
+							MarkdownDescription: "The ID of the job.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The type of the job.",
+							MarkdownDescription: "The type of the job.",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The state of the job.",
+							MarkdownDescription: "The state of the job.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "A description of the job.",
+							MarkdownDescription: "A description of the job.",
+						},
+						"policy": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The impact policy of the job.",
+							MarkdownDescription: "The impact policy of the job.",
+						},
+						"impact": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The current impact level of the job.",
+							MarkdownDescription: "The current impact level of the job.",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The priority of the job.",
+							MarkdownDescription: "The priority of the job.",
+						},
+						"current_phase": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The current phase of the job.",
+							MarkdownDescription: "The current phase of the job.",
+						},
+						"total_phases": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The total number of phases of the job.",
+							MarkdownDescription: "The total number of phases of the job.",
+						},
+						"progress": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The progress of the current phase of the job.",
+							MarkdownDescription: "The progress of the current phase of the job.",
+						},
+						"create_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The time the job was created in unix epoch seconds.",
+							MarkdownDescription: "The time the job was created in unix epoch seconds.",
+						},
+						"start_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The time the job was started in unix epoch seconds.",
+							MarkdownDescription: "The time the job was started in unix epoch seconds.",
+						},
+						"running_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of seconds the job has been running.",
+							MarkdownDescription: "The number of seconds the job has been running.",
+						},
+						"end_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The time the job ended in unix epoch seconds. Only set for finished jobs.",
+							MarkdownDescription: "The time the job ended in unix epoch seconds. Only set for finished jobs.",
+						},
+						"paths": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "The paths the job operates on.",
+							MarkdownDescription: "The paths the job operates on.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching Job Engine jobs.",
+				MarkdownDescription: "Filters for fetching Job Engine jobs.",
+				Attributes: map[string]schema.Attribute{
+					"state": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list jobs in this state.",
+						MarkdownDescription: "Only list jobs in this state.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"running",
+								"paused_user",
+								"paused_system",
+								"paused_policy",
+								"paused_priority",
+							),
+						},
+					},
+					"types": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Only list jobs of these types.",
+						MarkdownDescription: "Only list jobs of these types.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"sort": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The field that will be used for sorting.",
+						MarkdownDescription: "The field that will be used for sorting.",
+					},
+					"dir": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The direction of the sort.",
+						MarkdownDescription: "The direction of the sort.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("ASC", "DESC"),
+						},
+					},
+					"limit": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Return no more than this many results.",
+						MarkdownDescription: "Return no more than this many results.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, math.MaxInt32),
+						},
+					},
+					"include_finished": schema.BoolAttribute{
+						Optional: true,
+						Description: "Whether to also list the finished jobs recorded in the job events, most recent first, after the active jobs." +
+							" Only the ID, type, state and end time of a finished job are known. The sort and dir filters only apply to the active jobs.",
+						MarkdownDescription: "Whether to also list the finished jobs recorded in the job events, most recent first, after the active jobs." +
+							" Only the ID, type, state and end time of a finished job are known. The `sort` and `dir` filters only apply to the active jobs.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *JobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *JobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.JobDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobs, err := helper.ListJobs(ctx, d.client, data.Filter)
+	if err != nil {
+		errStr := constants.ListJobsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading jobs", message)
+		return
+	}
+
+	var finished []models.JobModel
+	if data.Filter != nil && data.Filter.IncludeFinished.ValueBool() {
+		// the finished jobs fill up what the active jobs leave of the limit
+		var maxJobs int64
+		if !data.Filter.Limit.IsNull() {
+			maxJobs = data.Filter.Limit.ValueInt64() - int64(len(jobs))
+		}
+		if data.Filter.Limit.IsNull() || maxJobs > 0 {
+			finished, err = helper.ListFinishedJobs(ctx, d.client, data.Filter, maxJobs)
+			if err != nil {
+				errStr := constants.ListJobsErrorMsg + "with error: "
+				message := helper.GetErrorString(err, errStr)
+				resp.Diagnostics.AddError("Error reading finished jobs", message)
+				return
+			}
+		}
+	}
+
+	state, err := helper.NewJobDataSource(ctx, jobs, finished)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map job fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccJobDataSource tests the job datasource.
+func TestAccJobDataSource(t *testing.T) {
+	dataSourceName := "data.powerscale_job.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read error
+			{
+				Config: ProviderConfig + `
+				data "powerscale_job" "test" {
+				}
+				`,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListJobs).Return(nil, fmt.Errorf("mock network error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock network error"),
+			},
+			// invalid filter
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + `
+				data "powerscale_job" "test" {
+					filter {
+						state = "invalid"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			// read all
+			{
+				Config: ProviderConfig + `
+				data "powerscale_job" "test" {
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "jobs.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + `
+				data "powerscale_job" "test" {
+					filter {
+						state = "running"
+						types = ["tfacc_nonexistent_type"]
+						sort = "id"
+						dir = "DESC"
+						limit = 5
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.#", "0"),
+				),
+			},
+			// read finished jobs error
+			{
+				Config: ProviderConfig + testAccJobDataSourceFinishedConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListFinishedJobs).Return(nil, fmt.Errorf("mock events error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock events error"),
+			},
+			// read with finished jobs
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccJobDataSourceFinishedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "jobs.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "filter.include_finished", "true"),
+				),
+			},
+		},
+	})
+}
+
+var testAccJobDataSourceFinishedConfig = `
+data "powerscale_job" "test" {
+	filter {
+		include_finished = true
+		limit = 5
+	}
+}
+`
@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// jobImpactPolicyIntervalRegex matches an impact policy interval boundary, such as "Sunday 00:00".
+var jobImpactPolicyIntervalRegex = regexp.MustCompile(`^(Sunday|Monday|Tuesday|Wednesday|Thursday|Friday|Saturday) ([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &JobImpactPolicyResource{}
+	_ resource.ResourceWithConfigure   = &JobImpactPolicyResource{}
+	_ resource.ResourceWithImportState = &JobImpactPolicyResource{}
+)
+
+// NewJobImpactPolicyResource creates a new resource.
+func NewJobImpactPolicyResource() resource.Resource {
+	return &JobImpactPolicyResource{}
+}
+
+// JobImpactPolicyResource defines the resource implementation.
+type JobImpactPolicyResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *JobImpactPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_impact_policy"
+}
+
+// Schema describes the resource arguments.
+func (r *JobImpactPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the Job Engine impact policies on PowerScale Array. " +
+			"An impact policy defines the impact level at which jobs run during a set of time intervals. " +
+			"We can Create, Update and Delete the impact policies using this resource. We can also import an existing impact policy from PowerScale array.",
+		Description: "This resource is used to manage the Job Engine impact policies on PowerScale Array. " +
+			"An impact policy defines the impact level at which jobs run during a set of time intervals. " +
+			"We can Create, Update and Delete the impact policies using this resource. We can also import an existing impact policy from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the impact policy.",
+				MarkdownDescription: "The ID of the impact policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the impact policy.",
+				MarkdownDescription: "The name of the impact policy.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "A helpful human-readable description of the impact policy.",
+				MarkdownDescription: "A helpful human-readable description of the impact policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"intervals": schema.ListNestedAttribute{
+				Required:            true,
+				Description:         "The time intervals of the impact policy and the impact level during each interval.",
+				MarkdownDescription: "The time intervals of the impact policy and the impact level during each interval.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"begin": schema.StringAttribute{
+							Required:            true,
+							Description:         "The start of the interval, in the format '<day> hh:mm', such as 'Sunday 00:00'.",
+							MarkdownDescription: "The start of the interval, in the format '<day> hh:mm', such as 'Sunday 00:00'.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(jobImpactPolicyIntervalRegex, "must be in the format '<day> hh:mm'"),
+							},
+						},
+						"end": schema.StringAttribute{
+							Required:            true,
+							Description:         "The end of the interval, in the format '<day> hh:mm', such as 'Saturday 23:59'.",
+							MarkdownDescription: "The end of the interval, in the format '<day> hh:mm', such as 'Saturday 23:59'.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(jobImpactPolicyIntervalRegex, "must be in the format '<day> hh:mm'"),
+							},
+						},
+						"impact": schema.StringAttribute{
+							Required:            true,
+							Description:         "The impact level during the interval. Acceptable values are Low, Medium, High and Paused.",
+							MarkdownDescription: "The impact level during the interval. Acceptable values are Low, Medium, High and Paused.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("Low", "Medium", "High", "Paused"),
+							},
+						},
+					},
+				},
+			},
+			"system": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether the impact policy is a system policy, which cannot be modified.",
+				MarkdownDescription: "Whether the impact policy is a system policy, which cannot be modified.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *JobImpactPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *JobImpactPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating job impact policy")
+	var plan X
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateJobImpactPolicy(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateJobImpactPolicyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating job impact policy", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Job impact policy %s created", id))
+
+	state := plan
+	if err := helper.GetJobImpactPolicyState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadJobImpactPolicyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading job impact policy after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create job impact policy completed")
+}
+
+// Read reads the resource state.
+func (r *JobImpactPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading job impact policy")
+	var state X
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetJobImpactPolicyState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadJobImpactPolicyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading job impact policy", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read job impact policy completed")
+}
+
+// Update updates the job impact policy.
+func (r *JobImpactPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating job impact policy")
+	var plan, state X
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := helper.UpdateJobImpactPolicy(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.UpdateJobImpactPolicyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating job impact policy", message)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.GetJobImpactPolicyState(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.ReadJobImpactPolicyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading job impact policy after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update job impact policy completed")
+}
+
+// Delete deletes the resource.
+func (r *JobImpactPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting job impact policy")
+	var state X
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteJobImpactPolicy(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteJobImpactPolicyErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting job impact policy", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete job impact policy completed")
+}
+
+// ImportState imports the resource state.
+func (r *JobImpactPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccJobImpactPolicyResource tests the job impact policy resource.
+func TestAccJobImpactPolicyResource(t *testing.T) {
+	resourceName := "powerscale_job_impact_policy.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid interval
+			{
+				Config: ProviderConfig + `
+				resource "powerscale_job_impact_policy" "test" {
+					name = "tfacc_impact_policy"
+					intervals = [
+						{
+							begin = "Funday 00:00"
+							end = "Saturday 23:59"
+							impact = "Low"
+						}
+					]
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*must be in the format.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccJobImpactPolicyResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateJobImpactPolicy).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccJobImpactPolicyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_impact_policy"),
+					resource.TestCheckResourceAttr(resourceName, "description", "tfacc impact policy"),
+					resource.TestCheckResourceAttr(resourceName, "intervals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "intervals.0.impact", "Low"),
+					resource.TestCheckResourceAttr(resourceName, "system", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccJobImpactPolicyResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateJobImpactPolicy).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// update
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccJobImpactPolicyResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_impact_policy_updated"),
+					resource.TestCheckResourceAttr(resourceName, "intervals.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "intervals.1.impact", "Paused"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccJobImpactPolicyResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetJobImpactPolicyState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccJobImpactPolicyResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteJobImpactPolicy).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccJobImpactPolicyResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccJobImpactPolicyResourceConfig = `
+resource "powerscale_job_impact_policy" "test" {
+	name = "tfacc_impact_policy"
+	description = "tfacc impact policy"
+	intervals = [
+		{
+			begin = "Sunday 00:00"
+			end = "Saturday 23:59"
+			impact = "Low"
+		}
+	]
+}
+`
+
+var testAccJobImpactPolicyResourceUpdateConfig = `
+resource "powerscale_job_impact_policy" "test" {
+	name = "tfacc_impact_policy_updated"
+	description = "tfacc impact policy"
+	intervals = [
+		{
+			begin = "Monday 08:00"
+			end = "Friday 18:00"
+			impact = "Medium"
+		},
+		{
+			begin = "Saturday 00:00"
+			end = "Saturday 23:59"
+			impact = "Paused"
+		}
+	]
+}
+`
@@ -0,0 +1,227 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &JobResource{}
+	_ resource.ResourceWithConfigure = &JobResource{}
+)
+
+// NewJobResource creates a new resource.
+func NewJobResource() resource.Resource {
+	return &JobResource{}
+}
+
+// JobResource defines the resource implementation.
+type JobResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *JobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema describes the resource arguments.
+func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to start a Job Engine job on PowerScale Array. " +
+			"Creating this resource starts a job of the given type, such as TreeDelete, SmartPools or IntegrityScan, " +
+			"and optionally waits for the job to complete. " +
+			"Changing any of the job parameters starts a new job. Deleting this resource only removes it from the Terraform state, it does not cancel the job.",
+		Description: "This resource is used to start a Job Engine job on PowerScale Array. " +
+			"Creating this resource starts a job of the given type, such as TreeDelete, SmartPools or IntegrityScan, " +
+			"and optionally waits for the job to complete. " +
+			"Changing any of the job parameters starts a new job. Deleting this resource only removes it from the Terraform state, it does not cancel the job.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the job.",
+				MarkdownDescription: "ID of the job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of the job to start, such as TreeDelete, SmartPools or IntegrityScan.",
+				MarkdownDescription: "The type of the job to start, such as TreeDelete, SmartPools or IntegrityScan.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "The paths the job operates on. Required by path based job types such as TreeDelete.",
+				MarkdownDescription: "The paths the job operates on. Required by path based job types such as TreeDelete.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The impact policy of the job. Defaults to the impact policy of the job type.",
+				MarkdownDescription: "The impact policy of the job. Defaults to the impact policy of the job type.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The priority of the job, from 1 (highest) to 10 (lowest). Defaults to the priority of the job type.",
+				MarkdownDescription: "The priority of the job, from 1 (highest) to 10 (lowest). Defaults to the priority of the job type.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"allow_dup": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to start the job even if another job of the same type is already running.",
+				MarkdownDescription: "Whether to start the job even if another job of the same type is already running.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to wait for the job to complete. If true, the resource fails when the job fails. Defaults to false.",
+				MarkdownDescription: "Whether to wait for the job to complete. If true, the resource fails when the job fails. Defaults to false.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The last observed state of the job.",
+				MarkdownDescription: "The last observed state of the job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *JobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create starts the job.
+func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Starting job")
+	var plan models.JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := helper.RunJob(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if state.ID.IsUnknown() {
+		// the job was never started, nothing to save
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Job completed")
+}
+
+// Read reads the resource state.
+// A job is a one-off operation, so the state recorded when the job was run is kept.
+func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading job")
+	var state models.JobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource state.
+// Only wait_for_completion can be updated in place, which does not start a new job.
+func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating job")
+	var plan, state models.JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.WaitForCompletion = plan.WaitForCompletion
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the resource from the state.
+func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting job resource state")
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var jobFinalStateMocker *mockey.Mocker
+
+// TestAccJobResource tests the job resource.
+func TestAccJobResource(t *testing.T) {
+	resourceName := "powerscale_job.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid priority
+			{
+				Config: ProviderConfig + `
+				resource "powerscale_job" "test" {
+					type = "IntegrityScan"
+					priority = 0
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccJobResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateJob).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// wait error
+			{
+				Config: ProviderConfig + testAccJobResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForJob).Return("", fmt.Errorf("mock wait error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock wait error.*`),
+			},
+			// job failed
+			{
+				Config: ProviderConfig + testAccJobResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForJob).Return("failed", nil).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*Job did not succeed.*`),
+			},
+			// job cancelled after it left the active jobs
+			{
+				Config: ProviderConfig + testAccJobResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetJobState).Return("", false, nil).Build()
+					jobFinalStateMocker = mockey.Mock(helper.GetJobFinalState).Return("cancelled_user", nil).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*finished in state cancelled_user.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					jobFinalStateMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForJob).Return("succeeded", nil).Build()
+				},
+				Config: ProviderConfig + testAccJobResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "TreeDelete"),
+					resource.TestCheckResourceAttr(resourceName, "paths.0", "/ifs/tfacc_job_tree_delete"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "succeeded"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// update in place
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccJobResourceNoWaitConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+var testAccJobResourceConfig = `
+resource "powerscale_job" "test" {
+	type = "TreeDelete"
+	paths = ["/ifs/tfacc_job_tree_delete"]
+	policy = "LOW"
+	priority = 4
+	wait_for_completion = true
+}
+`
+
+var testAccJobResourceNoWaitConfig = `
+resource "powerscale_job" "test" {
+	type = "TreeDelete"
+	paths = ["/ifs/tfacc_job_tree_delete"]
+	policy = "LOW"
+	priority = 4
+	wait_for_completion = false
+}
+`
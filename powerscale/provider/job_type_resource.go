@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &JobTypeResource{}
+	_ resource.ResourceWithConfigure   = &JobTypeResource{}
+	_ resource.ResourceWithImportState = &JobTypeResource{}
+)
+
+// NewJobTypeResource creates a new resource.
+func NewJobTypeResource() resource.Resource {
+	return &JobTypeResource{}
+}
+
+// JobTypeResource defines the resource implementation.
+type JobTypeResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *JobTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_type"
+}
+
+// Schema describes the resource arguments.
+func (r *JobTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the settings of a Job Engine job type on PowerScale Array. " +
+			"Job types are built into PowerScale, so creating this resource only takes over the management of the job type settings " +
+			"and deleting this resource only removes it from the Terraform state. We can also import an existing job type from PowerScale array.",
+		Description: "This resource is used to manage the settings of a Job Engine job type on PowerScale Array. " +
+			"Job types are built into PowerScale, so creating this resource only takes over the management of the job type settings " +
+			"and deleting this resource only removes it from the Terraform state. We can also import an existing job type from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the job type, such as SmartPools, TreeDelete or IntegrityScan.",
+				MarkdownDescription: "The name of the job type, such as SmartPools, TreeDelete or IntegrityScan.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the job type is enabled.",
+				MarkdownDescription: "Whether the job type is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The default priority of jobs of this type, from 1 (highest) to 10 (lowest).",
+				MarkdownDescription: "The default priority of jobs of this type, from 1 (highest) to 10 (lowest).",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The default impact policy of jobs of this type.",
+				MarkdownDescription: "The default impact policy of jobs of this type.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The schedule of the job type, such as 'every Saturday at 00:00'. An empty string removes the schedule.",
+				MarkdownDescription: "The schedule of the job type, such as 'every Saturday at 00:00'. An empty string removes the schedule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The description of the job type.",
+				MarkdownDescription: "The description of the job type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *JobTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create applies the job type settings from the plan.
+func (r *JobTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating job type")
+	var plan models.JobTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateJobType(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateJobTypeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating job type", message)
+		return
+	}
+
+	if err := helper.GetJobTypeState(ctx, r.client, plan.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadJobTypeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading job type after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Create job type completed")
+}
+
+// Read reads the resource state.
+func (r *JobTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading job type")
+	var state models.JobTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetJobTypeState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadJobTypeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading job type", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read job type completed")
+}
+
+// Update updates the job type settings.
+func (r *JobTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating job type")
+	var plan models.JobTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateJobType(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateJobTypeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating job type", message)
+		return
+	}
+
+	if err := helper.GetJobTypeState(ctx, r.client, plan.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadJobTypeErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading job type after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update job type completed")
+}
+
+// Delete removes the resource from the state.
+// The job type itself cannot be deleted, so its settings are left as they are.
+func (r *JobTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting job type resource state")
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the resource state.
+func (r *JobTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccJobTypeResource tests the job type resource.
+func TestAccJobTypeResource(t *testing.T) {
+	resourceName := "powerscale_job_type.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid priority
+			{
+				Config: ProviderConfig + `
+				resource "powerscale_job_type" "test" {
+					id = "IntegrityScan"
+					priority = 11
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccJobTypeResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateJobType).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccJobTypeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "IntegrityScan"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy", "LOW"),
+					resource.TestCheckResourceAttrSet(resourceName, "enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "description"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccJobTypeResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy", "MEDIUM"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccJobTypeResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetJobTypeState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccJobTypeResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccJobTypeResourceConfig = `
+resource "powerscale_job_type" "test" {
+	id = "IntegrityScan"
+	priority = 2
+	policy = "LOW"
+}
+`
+
+var testAccJobTypeResourceUpdateConfig = `
+resource "powerscale_job_type" "test" {
+	id = "IntegrityScan"
+	priority = 1
+	policy = "MEDIUM"
+}
+`
@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Krb5DomainResource{}
+	_ resource.ResourceWithConfigure   = &Krb5DomainResource{}
+	_ resource.ResourceWithImportState = &Krb5DomainResource{}
+)
+
+// NewKrb5DomainResource creates a new resource.
+func NewKrb5DomainResource() resource.Resource {
+	return &Krb5DomainResource{}
+}
+
+// Krb5DomainResource defines the resource implementation.
+type Krb5DomainResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *Krb5DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_krb5_domain"
+}
+
+// Schema describes the resource arguments.
+func (r *Krb5DomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the Kerberos domain entity of PowerScale Array. A Kerberos domain maps a domain to a Kerberos realm. We can Create, Update and Delete the Kerberos domain using this resource. We can also import an existing Kerberos domain from PowerScale array.",
+		Description:         "This resource is used to manage the Kerberos domain entity of PowerScale Array. A Kerberos domain maps a domain to a Kerberos realm. We can Create, Update and Delete the Kerberos domain using this resource. We can also import an existing Kerberos domain from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the Kerberos domain.",
+				MarkdownDescription: "The ID of the Kerberos domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the name of the domain. Updating this value recreates the Kerberos domain.",
+				MarkdownDescription: "Specifies the name of the domain. Updating this value recreates the Kerberos domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the name of the realm the domain is mapped to.",
+				MarkdownDescription: "Specifies the name of the realm the domain is mapped to.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *Krb5DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *Krb5DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Kerberos domain")
+	var plan models.Krb5DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateKrb5Domain(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateKrb5DomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating Kerberos domain", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetKrb5DomainState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadKrb5DomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos domain after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create Kerberos domain completed")
+}
+
+// Read reads the resource state.
+func (r *Krb5DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Kerberos domain")
+	var state models.Krb5DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetKrb5DomainState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadKrb5DomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos domain", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read Kerberos domain completed")
+}
+
+// Update updates the resource state.
+func (r *Krb5DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Kerberos domain")
+	var plan, state models.Krb5DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateKrb5Domain(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.UpdateKrb5DomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating Kerberos domain", message)
+		return
+	}
+
+	if err := helper.GetKrb5DomainState(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadKrb5DomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos domain after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update Kerberos domain completed")
+}
+
+// Delete deletes the resource.
+func (r *Krb5DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Kerberos domain")
+	var state models.Krb5DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteKrb5Domain(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteKrb5DomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting Kerberos domain", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete Kerberos domain completed")
+}
+
+// ImportState imports the resource state.
+func (r *Krb5DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccKrb5DomainResource tests the Kerberos domain resource.
+func TestAccKrb5DomainResource(t *testing.T) {
+	resourceName := "powerscale_krb5_domain.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccKrb5DomainResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateKrb5Domain).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5DomainResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "domain", ".tfacc.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "realm", "TFACC.EXAMPLE.COM"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccKrb5DomainResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "realm", "TFACC.EXAMPLE.ORG"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccKrb5DomainResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateKrb5Domain).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccKrb5DomainResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetKrb5DomainState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5DomainResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccKrb5DomainResourceConfig = `
+resource "powerscale_krb5_realm" "realm" {
+	realm = "TFACC.EXAMPLE.COM"
+	kdc = ["kdc1.tfacc.example.com"]
+}
+
+resource "powerscale_krb5_realm" "other" {
+	realm = "TFACC.EXAMPLE.ORG"
+	kdc = ["kdc1.tfacc.example.org"]
+}
+
+resource "powerscale_krb5_domain" "test" {
+	domain = ".tfacc.example.com"
+	realm = powerscale_krb5_realm.realm.realm
+}
+`
+
+var testAccKrb5DomainResourceUpdateConfig = `
+resource "powerscale_krb5_realm" "realm" {
+	realm = "TFACC.EXAMPLE.COM"
+	kdc = ["kdc1.tfacc.example.com"]
+}
+
+resource "powerscale_krb5_realm" "other" {
+	realm = "TFACC.EXAMPLE.ORG"
+	kdc = ["kdc1.tfacc.example.org"]
+}
+
+resource "powerscale_krb5_domain" "test" {
+	domain = ".tfacc.example.com"
+	realm = powerscale_krb5_realm.other.realm
+}
+`
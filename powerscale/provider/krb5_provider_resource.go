@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Krb5ProviderResource{}
+	_ resource.ResourceWithConfigure   = &Krb5ProviderResource{}
+	_ resource.ResourceWithImportState = &Krb5ProviderResource{}
+)
+
+// NewKrb5ProviderResource creates a new resource.
+func NewKrb5ProviderResource() resource.Resource {
+	return &Krb5ProviderResource{}
+}
+
+// Krb5ProviderResource defines the resource implementation.
+type Krb5ProviderResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *Krb5ProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_krb5_provider"
+}
+
+// Schema describes the resource arguments.
+func (r *Krb5ProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the standalone Kerberos provider entity of PowerScale Array. The provider is joined to a realm either with the credentials of a realm user or with a keytab file. We can Create, Update and Delete the Kerberos provider using this resource. We can also import an existing Kerberos provider from PowerScale array.",
+		Description:         "This resource is used to manage the standalone Kerberos provider entity of PowerScale Array. The provider is joined to a realm either with the credentials of a realm user or with a keytab file. We can Create, Update and Delete the Kerberos provider using this resource. We can also import an existing Kerberos provider from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the Kerberos provider, which is its name.",
+				MarkdownDescription: "The ID of the Kerberos provider, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the Kerberos provider name. Updating this value recreates the Kerberos provider.",
+				MarkdownDescription: "Specifies the Kerberos provider name. Updating this value recreates the Kerberos provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the name of the realm. Updating this value recreates the Kerberos provider.",
+				MarkdownDescription: "Specifies the name of the realm. Updating this value recreates the Kerberos provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the user name that has permission to join a machine to the given Kerberos realm.",
+				MarkdownDescription: "Specifies the user name that has permission to join a machine to the given Kerberos realm.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "Specifies the password used for authenticating to the Kerberos realm.",
+				MarkdownDescription: "Specifies the password used for authenticating to the Kerberos realm.",
+			},
+			"keytab_file": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the path to a keytab file to import.",
+				MarkdownDescription: "Specifies the path to a keytab file to import.",
+			},
+			"keytab_entries": schema.ListNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the key information for the Kerberos SPNs of the provider.",
+				MarkdownDescription: "Specifies the key information for the Kerberos SPNs of the provider.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kvno": schema.Int64Attribute{
+							Required:            true,
+							Description:         "Specifies the key version number.",
+							MarkdownDescription: "Specifies the key version number.",
+						},
+						"enctypes": schema.ListAttribute{
+							Required:            true,
+							ElementType:         types.StringType,
+							Description:         "Specifies the encryption types of the key.",
+							MarkdownDescription: "Specifies the encryption types of the key.",
+						},
+					},
+				},
+			},
+			"manual_keying": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, keys are managed manually. If false, keys are managed through kadmin.",
+				MarkdownDescription: "If true, keys are managed manually. If false, keys are managed through kadmin.",
+			},
+			"groupnet": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Groupnet identifier. Updating this value recreates the Kerberos provider.",
+				MarkdownDescription: "Groupnet identifier. Updating this value recreates the Kerberos provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the status of the provider.",
+				MarkdownDescription: "Specifies the status of the provider.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *Krb5ProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *Krb5ProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Kerberos provider")
+	var plan models.Krb5ProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.CreateKrb5Provider(ctx, r.client, &plan); err != nil {
+		errStr := constants.CreateKrb5ProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating Kerberos provider", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetKrb5ProviderState(ctx, r.client, plan.Name.ValueString(), &state); err != nil {
+		errStr := constants.ReadKrb5ProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos provider after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create Kerberos provider completed")
+}
+
+// Read reads the resource state.
+func (r *Krb5ProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Kerberos provider")
+	var state models.Krb5ProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetKrb5ProviderState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadKrb5ProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos provider", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read Kerberos provider completed")
+}
+
+// Update updates the resource state.
+func (r *Krb5ProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Kerberos provider")
+	var plan, state models.Krb5ProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateKrb5Provider(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateKrb5ProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating Kerberos provider", message)
+		return
+	}
+
+	if err := helper.GetKrb5ProviderState(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadKrb5ProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos provider after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update Kerberos provider completed")
+}
+
+// Delete deletes the resource.
+func (r *Krb5ProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Kerberos provider")
+	var state models.Krb5ProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteKrb5Provider(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteKrb5ProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting Kerberos provider", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete Kerberos provider completed")
+}
+
+// ImportState imports the resource state.
+func (r *Krb5ProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
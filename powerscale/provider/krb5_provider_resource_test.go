@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccKrb5ProviderPreCheck skips the Kerberos provider tests when no KDC is configured.
+func testAccKrb5ProviderPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	for _, env := range []string{"POWERSCALE_KRB5_REALM", "POWERSCALE_KRB5_KDC", "POWERSCALE_KRB5_USER", "POWERSCALE_KRB5_PASSWORD"} {
+		if os.Getenv(env) == "" {
+			t.Skipf("%s environment variable not set", env)
+		}
+	}
+}
+
+// testAccKrb5ProviderConfig returns the config of a Kerberos provider joined to the configured KDC.
+func testAccKrb5ProviderConfig(manualKeying bool) string {
+	return fmt.Sprintf(`
+resource "powerscale_krb5_realm" "test" {
+	realm = "%s"
+	kdc = ["%s"]
+	admin_server = "%s"
+}
+
+resource "powerscale_krb5_provider" "test" {
+	name = powerscale_krb5_realm.test.realm
+	realm = powerscale_krb5_realm.test.realm
+	user = "%s"
+	password = "%s"
+	manual_keying = %t
+}
+`, os.Getenv("POWERSCALE_KRB5_REALM"), os.Getenv("POWERSCALE_KRB5_KDC"), os.Getenv("POWERSCALE_KRB5_KDC"),
+		os.Getenv("POWERSCALE_KRB5_USER"), os.Getenv("POWERSCALE_KRB5_PASSWORD"), manualKeying)
+}
+
+// TestAccKrb5ProviderResource tests the Kerberos provider resource.
+func TestAccKrb5ProviderResource(t *testing.T) {
+	resourceName := "powerscale_krb5_provider.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccKrb5ProviderPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccKrb5ProviderConfig(false),
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateKrb5Provider).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5ProviderConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "manual_keying", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "keytab_entries.#"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccKrb5ProviderConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manual_keying", "true"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccKrb5ProviderConfig(false),
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateKrb5Provider).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccKrb5ProviderConfig(true),
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetKrb5ProviderState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5ProviderConfig(true),
+			},
+		},
+	})
+}
@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Krb5RealmResource{}
+	_ resource.ResourceWithConfigure   = &Krb5RealmResource{}
+	_ resource.ResourceWithImportState = &Krb5RealmResource{}
+)
+
+// NewKrb5RealmResource creates a new resource.
+func NewKrb5RealmResource() resource.Resource {
+	return &Krb5RealmResource{}
+}
+
+// Krb5RealmResource defines the resource implementation.
+type Krb5RealmResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *Krb5RealmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_krb5_realm"
+}
+
+// Schema describes the resource arguments.
+func (r *Krb5RealmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the Kerberos realm entity of PowerScale Array. A Kerberos realm specifies the KDCs and the admin server used by the standalone Kerberos providers. We can Create, Update and Delete the Kerberos realm using this resource. We can also import an existing Kerberos realm from PowerScale array.",
+		Description:         "This resource is used to manage the Kerberos realm entity of PowerScale Array. A Kerberos realm specifies the KDCs and the admin server used by the standalone Kerberos providers. We can Create, Update and Delete the Kerberos realm using this resource. We can also import an existing Kerberos realm from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the Kerberos realm.",
+				MarkdownDescription: "The ID of the Kerberos realm.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the name of the realm. Updating this value recreates the Kerberos realm.",
+				MarkdownDescription: "Specifies the name of the realm. Updating this value recreates the Kerberos realm.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kdc": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "Specifies the list of KDC addresses of the realm.",
+				MarkdownDescription: "Specifies the list of KDC addresses of the realm.",
+			},
+			"admin_server": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the administrative server hostname of the realm.",
+				MarkdownDescription: "Specifies the administrative server hostname of the realm.",
+			},
+			"default_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the default domain mapped to the realm.",
+				MarkdownDescription: "Specifies the default domain mapped to the realm.",
+			},
+			"is_default_realm": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, indicates that the realm is the default realm.",
+				MarkdownDescription: "If true, indicates that the realm is the default realm.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *Krb5RealmResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *Krb5RealmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Kerberos realm")
+	var plan models.Krb5RealmResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateKrb5Realm(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateKrb5RealmErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating Kerberos realm", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetKrb5RealmState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadKrb5RealmErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos realm after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create Kerberos realm completed")
+}
+
+// Read reads the resource state.
+func (r *Krb5RealmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Kerberos realm")
+	var state models.Krb5RealmResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetKrb5RealmState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadKrb5RealmErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos realm", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read Kerberos realm completed")
+}
+
+// Update updates the resource state.
+func (r *Krb5RealmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Kerberos realm")
+	var plan, state models.Krb5RealmResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateKrb5Realm(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.UpdateKrb5RealmErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating Kerberos realm", message)
+		return
+	}
+
+	if err := helper.GetKrb5RealmState(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadKrb5RealmErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos realm after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update Kerberos realm completed")
+}
+
+// Delete deletes the resource.
+func (r *Krb5RealmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Kerberos realm")
+	var state models.Krb5RealmResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteKrb5Realm(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteKrb5RealmErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting Kerberos realm", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete Kerberos realm completed")
+}
+
+// ImportState imports the resource state.
+func (r *Krb5RealmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccKrb5RealmResource tests the Kerberos realm resource.
+func TestAccKrb5RealmResource(t *testing.T) {
+	resourceName := "powerscale_krb5_realm.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccKrb5RealmResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateKrb5Realm).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5RealmResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "realm", "TFACC.EXAMPLE.COM"),
+					resource.TestCheckResourceAttr(resourceName, "kdc.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_server", "kdc1.tfacc.example.com"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccKrb5RealmResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kdc.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "admin_server", "kdc2.tfacc.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "default_domain", "tfacc.example.com"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccKrb5RealmResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateKrb5Realm).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccKrb5RealmResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetKrb5RealmState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5RealmResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccKrb5RealmResourceConfig = `
+resource "powerscale_krb5_realm" "test" {
+	realm = "TFACC.EXAMPLE.COM"
+	kdc = ["kdc1.tfacc.example.com"]
+	admin_server = "kdc1.tfacc.example.com"
+}
+`
+
+var testAccKrb5RealmResourceUpdateConfig = `
+resource "powerscale_krb5_realm" "test" {
+	realm = "TFACC.EXAMPLE.COM"
+	kdc = ["kdc1.tfacc.example.com", "kdc2.tfacc.example.com"]
+	admin_server = "kdc2.tfacc.example.com"
+	default_domain = "tfacc.example.com"
+}
+`
@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Krb5SettingsResource{}
+	_ resource.ResourceWithConfigure   = &Krb5SettingsResource{}
+	_ resource.ResourceWithImportState = &Krb5SettingsResource{}
+)
+
+// NewKrb5SettingsResource creates a new resource.
+func NewKrb5SettingsResource() resource.Resource {
+	return &Krb5SettingsResource{}
+}
+
+// Krb5SettingsResource defines the resource implementation.
+type Krb5SettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *Krb5SettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_krb5_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *Krb5SettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the Kerberos settings entity of PowerScale Array. We can Create, Update and Delete the Kerberos settings using this resource. " +
+			"We can also import the existing Kerberos settings from PowerScale array. Note that Kerberos settings cannot be deleted, deleting the resource only removes it from the state.",
+		Description: "This resource is used to manage the Kerberos settings entity of PowerScale Array. We can Create, Update and Delete the Kerberos settings using this resource. " +
+			"We can also import the existing Kerberos settings from PowerScale array. Note that Kerberos settings cannot be deleted, deleting the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the Kerberos settings.",
+				MarkdownDescription: "ID of the Kerberos settings.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"always_send_preauth": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, sends preauth for every request.",
+				MarkdownDescription: "If true, sends preauth for every request.",
+			},
+			"default_realm": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the realm used when a principal does not specify one.",
+				MarkdownDescription: "Specifies the realm used when a principal does not specify one.",
+			},
+			"dns_lookup_kdc": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, looks up the KDCs of a realm in DNS.",
+				MarkdownDescription: "If true, looks up the KDCs of a realm in DNS.",
+			},
+			"dns_lookup_realm": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, looks up the realm of a host in DNS.",
+				MarkdownDescription: "If true, looks up the realm of a host in DNS.",
+			},
+			"kdc_retries": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the number of retries to a KDC.",
+				MarkdownDescription: "Specifies the number of retries to a KDC.",
+			},
+			"kdc_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the timeout of a KDC request in seconds.",
+				MarkdownDescription: "Specifies the timeout of a KDC request in seconds.",
+			},
+			"preferred_enc_types": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "Specifies the preferred encryption types.",
+				MarkdownDescription: "Specifies the preferred encryption types.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *Krb5SettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *Krb5SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating Kerberos settings")
+	var plan models.Krb5SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateKrb5Settings(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateKrb5SettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating Kerberos settings", message)
+		return
+	}
+
+	var state models.Krb5SettingsResourceModel
+	if err := helper.GetKrb5SettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadKrb5SettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating Kerberos settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create Kerberos settings completed")
+}
+
+// Read reads the resource state.
+func (r *Krb5SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading Kerberos settings")
+	var state models.Krb5SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetKrb5SettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadKrb5SettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading Kerberos settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read Kerberos settings completed")
+}
+
+// Update updates the resource state.
+func (r *Krb5SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating Kerberos settings")
+	var plan models.Krb5SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateKrb5Settings(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateKrb5SettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating Kerberos settings", message)
+		return
+	}
+
+	var state models.Krb5SettingsResourceModel
+	if err := helper.GetKrb5SettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadKrb5SettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating Kerberos settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Update Kerberos settings completed")
+}
+
+// Delete removes the Kerberos settings from the state.
+func (r *Krb5SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting Kerberos settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete Kerberos settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *Krb5SettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state models.Krb5SettingsResourceModel
+	if err := helper.GetKrb5SettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadKrb5SettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing Kerberos settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccKrb5SettingsResource tests the Kerberos settings resource.
+func TestAccKrb5SettingsResource(t *testing.T) {
+	resourceName := "powerscale_krb5_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccKrb5SettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateKrb5Settings).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5SettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "krb5_settings"),
+					resource.TestCheckResourceAttr(resourceName, "always_send_preauth", "true"),
+					resource.TestCheckResourceAttr(resourceName, "kdc_retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "kdc_timeout", "3"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccKrb5SettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "always_send_preauth", "false"),
+					resource.TestCheckResourceAttr(resourceName, "kdc_retries", "5"),
+					resource.TestCheckResourceAttr(resourceName, "kdc_timeout", "10"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccKrb5SettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateKrb5Settings).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccKrb5SettingsResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetKrb5SettingsState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// restore the settings
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccKrb5SettingsResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccKrb5SettingsResourceConfig = `
+resource "powerscale_krb5_settings" "test" {
+	always_send_preauth = true
+	kdc_retries = 3
+	kdc_timeout = 3
+}
+`
+
+var testAccKrb5SettingsResourceUpdateConfig = `
+resource "powerscale_krb5_settings" "test" {
+	always_send_preauth = false
+	kdc_retries = 5
+	kdc_timeout = 10
+}
+`
@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &LocalProviderDataSource{}
+	_ datasource.DataSourceWithConfigure = &LocalProviderDataSource{}
+)
+
+// NewLocalProviderDataSource creates a new data source.
+func NewLocalProviderDataSource() datasource.DataSource {
+	return &LocalProviderDataSource{}
+}
+
+// LocalProviderDataSource defines the data source implementation.
+type LocalProviderDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *LocalProviderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_local_provider"
+}
+
+// Schema describes the data source arguments.
+func (d *LocalProviderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the existing local providers from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing local providers from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"local_providers": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of local providers.",
+				MarkdownDescription: "List of local providers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the local provider, which is its name.",
+							MarkdownDescription: "The ID of the local provider, which is its name.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the local provider name, which is the name of its access zone.",
+							MarkdownDescription: "Specifies the local provider name, which is the name of its access zone.",
+						},
+						"authentication": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables authentication and identity management through the authentication provider.",
+							MarkdownDescription: "If true, enables authentication and identity management through the authentication provider.",
+						},
+						"create_home_directory": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Automatically create the home directory on the first login.",
+							MarkdownDescription: "Automatically create the home directory on the first login.",
+						},
+						"home_directory_template": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the path to the home directory template.",
+							MarkdownDescription: "Specifies the path to the home directory template.",
+						},
+						"login_shell": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the login shell path.",
+							MarkdownDescription: "Specifies the login shell path.",
+						},
+						"machine_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the domain for this provider through which users and groups are qualified.",
+							MarkdownDescription: "Specifies the domain for this provider through which users and groups are qualified.",
+						},
+						"lockout_duration": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the length of time in seconds that an account will be inaccessible after multiple failed login attempts.",
+							MarkdownDescription: "Specifies the length of time in seconds that an account will be inaccessible after multiple failed login attempts.",
+						},
+						"lockout_threshold": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the number of failed login attempts necessary before an account is locked.",
+							MarkdownDescription: "Specifies the number of failed login attempts necessary before an account is locked.",
+						},
+						"lockout_window": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the duration of time in seconds in which the number of failed attempts set in lockout_threshold must be made for an account to be locked.",
+							MarkdownDescription: "Specifies the duration of time in seconds in which the number of failed attempts set in lockout_threshold must be made for an account to be locked.",
+						},
+						"max_password_age": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the maximum password age in seconds.",
+							MarkdownDescription: "Specifies the maximum password age in seconds.",
+						},
+						"min_password_age": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the minimum password age in seconds.",
+							MarkdownDescription: "Specifies the minimum password age in seconds.",
+						},
+						"min_password_length": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the minimum password length.",
+							MarkdownDescription: "Specifies the minimum password length.",
+						},
+						"password_complexity": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "List of cases required in a password. Options are lowercase, uppercase, numeric and symbol.",
+							MarkdownDescription: "List of cases required in a password. Options are lowercase, uppercase, numeric and symbol.",
+						},
+						"password_history_length": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the number of previous passwords to store.",
+							MarkdownDescription: "Specifies the number of previous passwords to store.",
+						},
+						"password_prompt_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the time in seconds remaining before a user will be prompted for a password change.",
+							MarkdownDescription: "Specifies the time in seconds remaining before a user will be prompted for a password change.",
+						},
+						"zone_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the name of the access zone in which this provider was created.",
+							MarkdownDescription: "Specifies the name of the access zone in which this provider was created.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the status of the provider.",
+							MarkdownDescription: "Specifies the status of the provider.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching local providers.",
+				MarkdownDescription: "Filters for fetching local providers.",
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Only list the local providers with these names.",
+						MarkdownDescription: "Only list the local providers with these names.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *LocalProviderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *LocalProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.LocalProviderDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := helper.ListLocalProviders(ctx, d.client)
+	if err != nil {
+		errStr := constants.ListLocalProvidersErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading local providers", message)
+		return
+	}
+
+	state, err := helper.NewLocalProviderDataSource(ctx, providers, data.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map local provider fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccLocalProviderDataSource tests the local provider data source.
+func TestAccLocalProviderDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// list all
+			{
+				Config: ProviderConfig + testAccLocalProviderDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_local_provider.all", "id", "local_provider_datasource"),
+					resource.TestCheckResourceAttrSet("data.powerscale_local_provider.all", "local_providers.#"),
+				),
+			},
+			// filter
+			{
+				Config: ProviderConfig + testAccLocalProviderDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_local_provider.filtered", "local_providers.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_local_provider.filtered", "local_providers.0.name", "System"),
+					resource.TestCheckResourceAttr("data.powerscale_local_provider.filtered", "local_providers.0.id", "System"),
+				),
+			},
+			// invalid filter
+			{
+				Config:      ProviderConfig + testAccLocalProviderDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*could not find local provider.*`),
+			},
+			// list error
+			{
+				Config: ProviderConfig + testAccLocalProviderDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListLocalProviders).Return(nil, fmt.Errorf("mock list error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock list error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccLocalProviderDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccLocalProviderDataSourceConfig = `
+data "powerscale_local_provider" "all" {
+}
+`
+
+var testAccLocalProviderDataSourceFilterConfig = testAccLocalProviderDataSourceConfig + `
+data "powerscale_local_provider" "filtered" {
+	filter {
+		names = ["System"]
+	}
+}
+`
+
+var testAccLocalProviderDataSourceInvalidConfig = `
+data "powerscale_local_provider" "invalid" {
+	filter {
+		names = ["tfacc_invalid_local_provider"]
+	}
+}
+`
@@ -0,0 +1,293 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &LocalProviderResource{}
+	_ resource.ResourceWithConfigure   = &LocalProviderResource{}
+	_ resource.ResourceWithImportState = &LocalProviderResource{}
+)
+
+// NewLocalProviderResource creates a new resource.
+func NewLocalProviderResource() resource.Resource {
+	return &LocalProviderResource{}
+}
+
+// LocalProviderResource defines the resource implementation.
+type LocalProviderResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *LocalProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_local_provider"
+}
+
+// Schema describes the resource arguments.
+func (r *LocalProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the local provider entity of PowerScale Array. Every access zone has one local provider which is named after the zone and cannot be created or deleted, so creating this resource takes over the settings of the existing local provider and destroying it only removes it from the state. We can Create, Update and Delete the local provider using this resource. We can also import an existing local provider from PowerScale array.",
+		Description:         "This resource is used to manage the local provider entity of PowerScale Array. Every access zone has one local provider which is named after the zone and cannot be created or deleted, so creating this resource takes over the settings of the existing local provider and destroying it only removes it from the state. We can Create, Update and Delete the local provider using this resource. We can also import an existing local provider from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the local provider, which is its name.",
+				MarkdownDescription: "The ID of the local provider, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the local provider name, which is the name of its access zone. Updating this value recreates the local provider.",
+				MarkdownDescription: "Specifies the local provider name, which is the name of its access zone. Updating this value recreates the local provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authentication": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables authentication and identity management through the authentication provider.",
+				MarkdownDescription: "If true, enables authentication and identity management through the authentication provider.",
+			},
+			"create_home_directory": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Automatically create the home directory on the first login.",
+				MarkdownDescription: "Automatically create the home directory on the first login.",
+			},
+			"home_directory_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the path to the home directory template.",
+				MarkdownDescription: "Specifies the path to the home directory template.",
+			},
+			"login_shell": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the login shell path.",
+				MarkdownDescription: "Specifies the login shell path.",
+			},
+			"machine_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the domain for this provider through which users and groups are qualified.",
+				MarkdownDescription: "Specifies the domain for this provider through which users and groups are qualified.",
+			},
+			"lockout_duration": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the length of time in seconds that an account will be inaccessible after multiple failed login attempts.",
+				MarkdownDescription: "Specifies the length of time in seconds that an account will be inaccessible after multiple failed login attempts.",
+			},
+			"lockout_threshold": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the number of failed login attempts necessary before an account is locked.",
+				MarkdownDescription: "Specifies the number of failed login attempts necessary before an account is locked.",
+			},
+			"lockout_window": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the duration of time in seconds in which the number of failed attempts set in lockout_threshold must be made for an account to be locked.",
+				MarkdownDescription: "Specifies the duration of time in seconds in which the number of failed attempts set in lockout_threshold must be made for an account to be locked.",
+			},
+			"max_password_age": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the maximum password age in seconds.",
+				MarkdownDescription: "Specifies the maximum password age in seconds.",
+			},
+			"min_password_age": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the minimum password age in seconds.",
+				MarkdownDescription: "Specifies the minimum password age in seconds.",
+			},
+			"min_password_length": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the minimum password length.",
+				MarkdownDescription: "Specifies the minimum password length.",
+			},
+			"password_complexity": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "List of cases required in a password. Options are lowercase, uppercase, numeric and symbol.",
+				MarkdownDescription: "List of cases required in a password. Options are lowercase, uppercase, numeric and symbol.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("lowercase", "uppercase", "numeric", "symbol")),
+				},
+			},
+			"password_history_length": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the number of previous passwords to store.",
+				MarkdownDescription: "Specifies the number of previous passwords to store.",
+			},
+			"password_prompt_time": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the time in seconds remaining before a user will be prompted for a password change.",
+				MarkdownDescription: "Specifies the time in seconds remaining before a user will be prompted for a password change.",
+			},
+			"zone_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the name of the access zone in which this provider was created.",
+				MarkdownDescription: "Specifies the name of the access zone in which this provider was created.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the status of the provider.",
+				MarkdownDescription: "Specifies the status of the provider.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *LocalProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create updates the existing local provider of the access zone to the planned settings.
+func (r *LocalProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating local provider")
+	var plan models.LocalProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.CreateLocalProvider(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateLocalProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating local provider", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetLocalProviderState(ctx, r.client, plan.Name.ValueString(), &state); err != nil {
+		errStr := constants.ReadLocalProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading local provider after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create local provider completed")
+}
+
+// Read reads the resource state.
+func (r *LocalProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading local provider")
+	var state models.LocalProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetLocalProviderState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadLocalProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading local provider", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read local provider completed")
+}
+
+// Update updates the resource state.
+func (r *LocalProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating local provider")
+	var plan, state models.LocalProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateLocalProvider(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateLocalProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating local provider", message)
+		return
+	}
+
+	if err := helper.GetLocalProviderState(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadLocalProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading local provider after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update local provider completed")
+}
+
+// Delete removes the local provider from the state, the local provider of an access zone cannot be deleted.
+func (r *LocalProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting local provider")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete local provider completed")
+}
+
+// ImportState imports the resource state.
+func (r *LocalProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
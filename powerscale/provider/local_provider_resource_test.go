@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccLocalProviderResource tests the local provider resource.
+func TestAccLocalProviderResource(t *testing.T) {
+	resourceName := "powerscale_local_provider.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccLocalProviderResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateLocalProvider).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccLocalProviderResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "System"),
+					resource.TestCheckResourceAttr(resourceName, "name", "System"),
+					resource.TestCheckResourceAttr(resourceName, "lockout_threshold", "0"),
+					resource.TestCheckResourceAttr(resourceName, "min_password_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "password_complexity.#", "0"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccLocalProviderResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "lockout_threshold", "5"),
+					resource.TestCheckResourceAttr(resourceName, "min_password_length", "8"),
+					resource.TestCheckResourceAttr(resourceName, "password_complexity.#", "2"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccLocalProviderResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateLocalProvider).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccLocalProviderResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetLocalProviderState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccLocalProviderResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccLocalProviderResourceConfig = `
+resource "powerscale_local_provider" "test" {
+	name = "System"
+	lockout_threshold = 0
+	min_password_length = 0
+	password_complexity = []
+}
+`
+
+var testAccLocalProviderResourceUpdateConfig = `
+resource "powerscale_local_provider" "test" {
+	name = "System"
+	lockout_threshold = 5
+	min_password_length = 8
+	password_complexity = ["lowercase", "numeric"]
+}
+`
@@ -21,6 +21,7 @@ import (
 	"context"
 	powerscale "dell/powerscale-go-client"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -250,6 +251,13 @@ func (r *NetworkPoolResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -286,6 +294,14 @@ func (r *NetworkPoolResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	poolToCreate := powerscale.V12SubnetsSubnetPool{}
 	// Get param from tf input
 	err := helper.ReadFromState(ctx, plan, &poolToCreate)
@@ -427,6 +443,14 @@ func (r *NetworkPoolResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := poolPlan.Timeouts.Update(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "calling update network pool", map[string]interface{}{
 		"poolPlan":  poolPlan,
 		"poolState": poolState,
@@ -508,6 +532,14 @@ func (r *NetworkPoolResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := poolState.Timeouts.Delete(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	poolID := poolState.ID.ValueString()
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
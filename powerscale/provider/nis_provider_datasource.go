@@ -0,0 +1,275 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &NISProviderDataSource{}
+	_ datasource.DataSourceWithConfigure = &NISProviderDataSource{}
+)
+
+// NewNISProviderDataSource creates a new data source.
+func NewNISProviderDataSource() datasource.DataSource {
+	return &NISProviderDataSource{}
+}
+
+// NISProviderDataSource defines the data source implementation.
+type NISProviderDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *NISProviderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nis_provider"
+}
+
+// Schema describes the data source arguments.
+func (d *NISProviderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the existing NIS providers from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing NIS providers from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"nis_providers": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of NIS providers.",
+				MarkdownDescription: "List of NIS providers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the NIS provider, which is its name.",
+							MarkdownDescription: "The ID of the NIS provider, which is its name.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the NIS provider name.",
+							MarkdownDescription: "Specifies the NIS provider name.",
+						},
+						"nis_domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the NIS domain name.",
+							MarkdownDescription: "Specifies the NIS domain name.",
+						},
+						"servers": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "Specifies the NIS servers to be used for authentication lookups.",
+							MarkdownDescription: "Specifies the NIS servers to be used for authentication lookups.",
+						},
+						"groupnet": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Groupnet identifier.",
+							MarkdownDescription: "Groupnet identifier.",
+						},
+						"authentication": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables authentication and identity management through the authentication provider.",
+							MarkdownDescription: "If true, enables authentication and identity management through the authentication provider.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables the NIS provider.",
+							MarkdownDescription: "If true, enables the NIS provider.",
+						},
+						"balance_servers": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, connects the provider to a random server.",
+							MarkdownDescription: "If true, connects the provider to a random server.",
+						},
+						"check_online_interval": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the time in seconds between provider online checks.",
+							MarkdownDescription: "Specifies the time in seconds between provider online checks.",
+						},
+						"request_timeout": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the request timeout interval in seconds.",
+							MarkdownDescription: "Specifies the request timeout interval in seconds.",
+						},
+						"retry_time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Specifies the timeout period in seconds after which a request will be retried.",
+							MarkdownDescription: "Specifies the timeout period in seconds after which a request will be retried.",
+						},
+						"hostname_lookup": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables host name look ups.",
+							MarkdownDescription: "If true, enables host name look ups.",
+						},
+						"create_home_directory": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Automatically create the home directory on the first login.",
+							MarkdownDescription: "Automatically create the home directory on the first login.",
+						},
+						"home_directory_template": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the path to the home directory template.",
+							MarkdownDescription: "Specifies the path to the home directory template.",
+						},
+						"login_shell": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the login shell path.",
+							MarkdownDescription: "Specifies the login shell path.",
+						},
+						"enumerate_users": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables the provider to enumerate users.",
+							MarkdownDescription: "If true, enables the provider to enumerate users.",
+						},
+						"enumerate_groups": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, enables the provider to enumerate groups.",
+							MarkdownDescription: "If true, enables the provider to enumerate groups.",
+						},
+						"user_domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the domain for this provider through which users are qualified.",
+							MarkdownDescription: "Specifies the domain for this provider through which users are qualified.",
+						},
+						"group_domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the domain for this provider through which groups are qualified.",
+							MarkdownDescription: "Specifies the domain for this provider through which groups are qualified.",
+						},
+						"provider_domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the domain for the provider.",
+							MarkdownDescription: "Specifies the domain for the provider.",
+						},
+						"ntlm_support": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+							MarkdownDescription: "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+						},
+						"normalize_users": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Normalizes user names to lowercase before look up.",
+							MarkdownDescription: "Normalizes user names to lowercase before look up.",
+						},
+						"normalize_groups": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Normalizes group names to lowercase before look up.",
+							MarkdownDescription: "Normalizes group names to lowercase before look up.",
+						},
+						"restrict_findable": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+							MarkdownDescription: "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+						},
+						"restrict_listable": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+							MarkdownDescription: "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+						},
+						"zone_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the name of the access zone in which this provider was created.",
+							MarkdownDescription: "Specifies the name of the access zone in which this provider was created.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Specifies the status of the provider.",
+							MarkdownDescription: "Specifies the status of the provider.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching NIS providers.",
+				MarkdownDescription: "Filters for fetching NIS providers.",
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Only list the NIS providers with these names.",
+						MarkdownDescription: "Only list the NIS providers with these names.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *NISProviderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *NISProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.NISProviderDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := helper.ListNISProviders(ctx, d.client)
+	if err != nil {
+		errStr := constants.ListNISProvidersErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NIS providers", message)
+		return
+	}
+
+	state, err := helper.NewNISProviderDataSource(ctx, providers, data.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map NIS provider fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccNISProviderDataSource tests the NIS provider data source.
+func TestAccNISProviderDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// list all
+			{
+				Config: ProviderConfig + testAccNISProviderDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_nis_provider.all", "id", "nis_provider_datasource"),
+					resource.TestCheckResourceAttrSet("data.powerscale_nis_provider.all", "nis_providers.#"),
+				),
+			},
+			// filter
+			{
+				Config: ProviderConfig + testAccNISProviderDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_nis_provider.filtered", "nis_providers.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_nis_provider.filtered", "nis_providers.0.name", "tfacc_nis_provider"),
+					resource.TestCheckResourceAttr("data.powerscale_nis_provider.filtered", "nis_providers.0.id", "tfacc_nis_provider"),
+				),
+			},
+			// invalid filter
+			{
+				Config:      ProviderConfig + testAccNISProviderDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*could not find NIS provider.*`),
+			},
+			// list error
+			{
+				Config: ProviderConfig + testAccNISProviderDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListNISProviders).Return(nil, fmt.Errorf("mock list error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock list error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccNISProviderDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccNISProviderDataSourceConfig = `
+resource "powerscale_nis_provider" "test" {
+	name = "tfacc_nis_provider"
+	nis_domain = "example.com"
+	servers = ["10.10.10.10"]
+}
+
+data "powerscale_nis_provider" "all" {
+	depends_on = [powerscale_nis_provider.test]
+}
+`
+
+var testAccNISProviderDataSourceFilterConfig = testAccNISProviderDataSourceConfig + `
+data "powerscale_nis_provider" "filtered" {
+	depends_on = [powerscale_nis_provider.test]
+	filter {
+		names = ["tfacc_nis_provider"]
+	}
+}
+`
+
+var testAccNISProviderDataSourceInvalidConfig = `
+data "powerscale_nis_provider" "invalid" {
+	filter {
+		names = ["tfacc_invalid_nis_provider"]
+	}
+}
+`
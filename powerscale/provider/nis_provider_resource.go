@@ -0,0 +1,364 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NISProviderResource{}
+	_ resource.ResourceWithConfigure   = &NISProviderResource{}
+	_ resource.ResourceWithImportState = &NISProviderResource{}
+)
+
+// NewNISProviderResource creates a new resource.
+func NewNISProviderResource() resource.Resource {
+	return &NISProviderResource{}
+}
+
+// NISProviderResource defines the resource implementation.
+type NISProviderResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *NISProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nis_provider"
+}
+
+// Schema describes the resource arguments.
+func (r *NISProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the NIS provider entity of PowerScale Array. We can Create, Update and Delete the NIS provider using this resource. We can also import an existing NIS provider from PowerScale array.",
+		Description:         "This resource is used to manage the NIS provider entity of PowerScale Array. We can Create, Update and Delete the NIS provider using this resource. We can also import an existing NIS provider from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the NIS provider, which is its name.",
+				MarkdownDescription: "The ID of the NIS provider, which is its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the NIS provider name. Updating this value recreates the NIS provider.",
+				MarkdownDescription: "Specifies the NIS provider name. Updating this value recreates the NIS provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nis_domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "Specifies the NIS domain name.",
+				MarkdownDescription: "Specifies the NIS domain name.",
+			},
+			"servers": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "Specifies the NIS servers to be used for authentication lookups.",
+				MarkdownDescription: "Specifies the NIS servers to be used for authentication lookups.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"groupnet": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Groupnet identifier. Updating this value recreates the NIS provider.",
+				MarkdownDescription: "Groupnet identifier. Updating this value recreates the NIS provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authentication": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables authentication and identity management through the authentication provider.",
+				MarkdownDescription: "If true, enables authentication and identity management through the authentication provider.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables the NIS provider.",
+				MarkdownDescription: "If true, enables the NIS provider.",
+			},
+			"balance_servers": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, connects the provider to a random server.",
+				MarkdownDescription: "If true, connects the provider to a random server.",
+			},
+			"check_online_interval": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the time in seconds between provider online checks.",
+				MarkdownDescription: "Specifies the time in seconds between provider online checks.",
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the request timeout interval in seconds.",
+				MarkdownDescription: "Specifies the request timeout interval in seconds.",
+			},
+			"retry_time": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the timeout period in seconds after which a request will be retried.",
+				MarkdownDescription: "Specifies the timeout period in seconds after which a request will be retried.",
+			},
+			"hostname_lookup": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables host name look ups.",
+				MarkdownDescription: "If true, enables host name look ups.",
+			},
+			"create_home_directory": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Automatically create the home directory on the first login.",
+				MarkdownDescription: "Automatically create the home directory on the first login.",
+			},
+			"home_directory_template": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the path to the home directory template.",
+				MarkdownDescription: "Specifies the path to the home directory template.",
+			},
+			"login_shell": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the login shell path.",
+				MarkdownDescription: "Specifies the login shell path.",
+			},
+			"enumerate_users": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables the provider to enumerate users.",
+				MarkdownDescription: "If true, enables the provider to enumerate users.",
+			},
+			"enumerate_groups": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, enables the provider to enumerate groups.",
+				MarkdownDescription: "If true, enables the provider to enumerate groups.",
+			},
+			"user_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the domain for this provider through which users are qualified.",
+				MarkdownDescription: "Specifies the domain for this provider through which users are qualified.",
+			},
+			"group_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the domain for this provider through which groups are qualified.",
+				MarkdownDescription: "Specifies the domain for this provider through which groups are qualified.",
+			},
+			"provider_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies the domain for the provider.",
+				MarkdownDescription: "Specifies the domain for the provider.",
+			},
+			"ntlm_support": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+				MarkdownDescription: "Specifies which NTLM versions to support for users with NTLM-compatible credentials. Options are all, v2only and none.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "v2only", "none"),
+				},
+			},
+			"normalize_users": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Normalizes user names to lowercase before look up.",
+				MarkdownDescription: "Normalizes user names to lowercase before look up.",
+			},
+			"normalize_groups": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Normalizes group names to lowercase before look up.",
+				MarkdownDescription: "Normalizes group names to lowercase before look up.",
+			},
+			"restrict_findable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+				MarkdownDescription: "If true, checks the provider for filtered lists of findable and unfindable users and groups.",
+			},
+			"restrict_listable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+				MarkdownDescription: "If true, checks the provider for filtered lists of listable and unlistable users and groups.",
+			},
+			"zone_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the name of the access zone in which this provider was created.",
+				MarkdownDescription: "Specifies the name of the access zone in which this provider was created.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Specifies the status of the provider.",
+				MarkdownDescription: "Specifies the status of the provider.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *NISProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *NISProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating NIS provider")
+	var plan models.NISProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.CreateNISProvider(ctx, r.client, &plan); err != nil {
+		errStr := constants.CreateNISProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating NIS provider", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetNISProviderState(ctx, r.client, plan.Name.ValueString(), &state); err != nil {
+		errStr := constants.ReadNISProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NIS provider after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create NIS provider completed")
+}
+
+// Read reads the resource state.
+func (r *NISProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading NIS provider")
+	var state models.NISProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetNISProviderState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadNISProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NIS provider", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read NIS provider completed")
+}
+
+// Update updates the resource state.
+func (r *NISProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating NIS provider")
+	var plan, state models.NISProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateNISProvider(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateNISProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating NIS provider", message)
+		return
+	}
+
+	if err := helper.GetNISProviderState(ctx, r.client, state.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadNISProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading NIS provider after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update NIS provider completed")
+}
+
+// Delete deletes the resource.
+func (r *NISProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting NIS provider")
+	var state models.NISProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteNISProvider(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteNISProviderErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting NIS provider", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete NIS provider completed")
+}
+
+// ImportState imports the resource state.
+func (r *NISProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccNISProviderResource tests the NIS provider resource.
+func TestAccNISProviderResource(t *testing.T) {
+	resourceName := "powerscale_nis_provider.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccNISProviderResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateNISProvider).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccNISProviderResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tfacc_nis_provider"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_nis_provider"),
+					resource.TestCheckResourceAttr(resourceName, "nis_domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "groupnet", "groupnet0"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccNISProviderResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "nis_domain", "example.org"),
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "request_timeout", "30"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccNISProviderResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateNISProvider).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccNISProviderResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetNISProviderState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccNISProviderResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccNISProviderResourceConfig = `
+resource "powerscale_nis_provider" "test" {
+	name = "tfacc_nis_provider"
+	nis_domain = "example.com"
+	servers = ["10.10.10.10"]
+}
+`
+
+var testAccNISProviderResourceUpdateConfig = `
+resource "powerscale_nis_provider" "test" {
+	name = "tfacc_nis_provider"
+	nis_domain = "example.org"
+	servers = ["10.10.10.10", "10.10.10.11"]
+	request_timeout = 30
+}
+`
@@ -19,15 +19,22 @@ package provider
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"regexp"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/helper"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -57,6 +64,21 @@ type Data struct {
 	Insecure types.Bool   `tfsdk:"insecure"`
 	AuthType types.Int64  `tfsdk:"auth_type"`
 	Timeout  types.Int64  `tfsdk:"timeout"`
+
+	RetryMaxAttempts types.Int64 `tfsdk:"retry_max_attempts"`
+	RetryMinBackoff  types.Int64 `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff  types.Int64 `tfsdk:"retry_max_backoff"`
+	RetryStatusCodes types.List  `tfsdk:"retry_status_codes"`
+
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+
+	CACertificate           types.String `tfsdk:"ca_certificate"`
+	CACertificateFile       types.String `tfsdk:"ca_certificate_file"`
+	TLSServerName           types.String `tfsdk:"tls_server_name"`
+	CertificateFingerprints types.List   `tfsdk:"certificate_fingerprints"`
+	ClientCertificate       types.String `tfsdk:"client_certificate"`
+	ClientKey               types.String `tfsdk:"client_key"`
 }
 
 // Metadata describes the provider arguments.
@@ -111,6 +133,114 @@ func (p *PscaleProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Description:         "specifies a time limit for requests",
 				Optional:            true,
 			},
+			"retry_max_attempts": schema.Int64Attribute{
+				MarkdownDescription: "the maximum number of attempts of a request which failed with a transient error, including the first attempt. Defaults to 1, which disables retries. " +
+					"Idempotent requests are retried on connection errors and retryable status codes, other requests are only retried when rejected with 429 or 503.",
+				Description: "the maximum number of attempts of a request which failed with a transient error, including the first attempt. Defaults to 1, which disables retries. " +
+					"Idempotent requests are retried on connection errors and retryable status codes, other requests are only retried when rejected with 429 or 503.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 20),
+				},
+			},
+			"retry_min_backoff": schema.Int64Attribute{
+				MarkdownDescription: "the wait in seconds before the first retry of a request, doubled with every further retry and randomized with jitter. Defaults to 1.",
+				Description:         "the wait in seconds before the first retry of a request, doubled with every further retry and randomized with jitter. Defaults to 1.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_backoff": schema.Int64Attribute{
+				MarkdownDescription: "the maximum wait in seconds between two attempts of a request. Defaults to 30.",
+				Description:         "the maximum wait in seconds between two attempts of a request. Defaults to 30.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_status_codes": schema.ListAttribute{
+				MarkdownDescription: "the HTTP status codes of responses which are retried. Defaults to 429, 502, 503 and 504.",
+				Description:         "the HTTP status codes of responses which are retried. Defaults to 429, 502, 503 and 504.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "the maximum number of API requests in flight at the same time, shared by all the resources and data sources of this provider. Not limited by default.",
+				Description:         "the maximum number of API requests in flight at the same time, shared by all the resources and data sources of this provider. Not limited by default.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "the maximum rate of API requests per second, shared by all the resources and data sources of this provider. Retries count against the rate. Not limited by default.",
+				Description:         "the maximum rate of API requests per second, shared by all the resources and data sources of this provider. Retries count against the rate. Not limited by default.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "the PEM encoded CA certificates trusted, in addition to the system ones, to verify the cluster certificate. Conflicts with `ca_certificate_file`.",
+				Description:         "the PEM encoded CA certificates trusted, in addition to the system ones, to verify the cluster certificate. Conflicts with 'ca_certificate_file'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate_file")),
+				},
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				MarkdownDescription: "the path of a PEM file with the CA certificates trusted, in addition to the system ones, to verify the cluster certificate. Conflicts with `ca_certificate`.",
+				Description:         "the path of a PEM file with the CA certificates trusted, in addition to the system ones, to verify the cluster certificate. Conflicts with 'ca_certificate'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "the server name sent with SNI and verified against the cluster certificate, when it differs from the host of the endpoint.",
+				Description:         "the server name sent with SNI and verified against the cluster certificate, when it differs from the host of the endpoint.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certificate_fingerprints": schema.ListAttribute{
+				MarkdownDescription: "the SHA-256 fingerprints, in hex with optional colons, of which one must match a certificate presented by the cluster. Also enforced when `insecure` is true.",
+				Description:         "the SHA-256 fingerprints, in hex with optional colons, of which one must match a certificate presented by the cluster. Also enforced when 'insecure' is true.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([0-9a-fA-F]{2}:?){31}[0-9a-fA-F]{2}$`), "must be a SHA-256 fingerprint in hex"),
+					),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "the PEM encoded certificate presented to the cluster. Requires `client_key`.",
+				Description:         "the PEM encoded certificate presented to the cluster. Requires 'client_key'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "the PEM encoded private key of the client certificate. Requires `client_certificate`.",
+				Description:         "the PEM encoded private key of the client certificate. Requires 'client_certificate'.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate")),
+				},
+			},
 		},
 	}
 }
@@ -133,6 +263,62 @@ func (p *PscaleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if data.AuthType.IsNull() || data.AuthType.IsUnknown() {
 		data.AuthType = types.Int64Value(1)
 	}
+	// If retry settings are not set, requests are not retried
+	if data.RetryMaxAttempts.IsNull() || data.RetryMaxAttempts.IsUnknown() {
+		data.RetryMaxAttempts = types.Int64Value(1)
+	}
+	if data.RetryMinBackoff.IsNull() || data.RetryMinBackoff.IsUnknown() {
+		data.RetryMinBackoff = types.Int64Value(1)
+	}
+	if data.RetryMaxBackoff.IsNull() || data.RetryMaxBackoff.IsUnknown() {
+		data.RetryMaxBackoff = types.Int64Value(30)
+	}
+	var retryStatusCodes []int64
+	if !data.RetryStatusCodes.IsNull() && !data.RetryStatusCodes.IsUnknown() {
+		resp.Diagnostics.Append(data.RetryStatusCodes.ElementsAs(ctx, &retryStatusCodes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	opts := client.Options{
+		Retry: client.RetryPolicy{
+			MaxAttempts: int(data.RetryMaxAttempts.ValueInt64()),
+			MinBackoff:  time.Duration(data.RetryMinBackoff.ValueInt64()) * time.Second,
+			MaxBackoff:  time.Duration(data.RetryMaxBackoff.ValueInt64()) * time.Second,
+		},
+		// If rate limit settings are not set, requests are not limited
+		RateLimit: client.RateLimit{
+			MaxConcurrentRequests: int(data.MaxConcurrentRequests.ValueInt64()),
+			RequestsPerSecond:     data.RequestsPerSecond.ValueFloat64(),
+		},
+	}
+	for _, code := range retryStatusCodes {
+		opts.Retry.StatusCodes = append(opts.Retry.StatusCodes, int(code))
+	}
+
+	// If TLS settings are not set, the cluster certificate is verified against the system CAs only
+	opts.TLS = client.TLSOptions{
+		CACertificates:    []byte(data.CACertificate.ValueString()),
+		ServerName:        data.TLSServerName.ValueString(),
+		ClientCertificate: []byte(data.ClientCertificate.ValueString()),
+		ClientKey:         []byte(data.ClientKey.ValueString()),
+	}
+	if caFile := data.CACertificateFile.ValueString(); caFile != "" {
+		caCertificates, err := os.ReadFile(filepath.Clean(caFile))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read the CA certificate file", err.Error())
+			return
+		}
+		opts.TLS.CACertificates = caCertificates
+	}
+	if !data.CertificateFingerprints.IsNull() && !data.CertificateFingerprints.IsUnknown() {
+		resp.Diagnostics.Append(data.CertificateFingerprints.ElementsAs(ctx, &opts.TLS.CertificateFingerprints, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Configuration values are now available.
 	pscaleClient, err := client.NewClient(
 		data.Endpoint.ValueString(),
@@ -141,6 +327,7 @@ func (p *PscaleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		data.Password.ValueString(),
 		data.AuthType.ValueInt64(),
 		data.Timeout.ValueInt64(),
+		opts,
 	)
 
 	if err != nil {
@@ -202,6 +389,38 @@ func (p *PscaleProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewClusterTimeResource,
 		NewSyncIQPeerCertificateResource,
 		NewSupportAssistResource,
+		NewSyncIQRuleResource,
+		NewSyncIQJobResource,
+		NewSnapshotAliasResource,
+		NewSnapshotLockResource,
+		NewJobTypeResource,
+		NewJobImpactPolicyResource,
+		NewJobResource,
+		NewQuotaNotificationResource,
+		NewQuotaDefaultNotificationResource,
+		NewQuotaReportSettingsResource,
+		NewQuotaMappingSettingsResource,
+		NewQuotaReportResource,
+		NewFileResource,
+		NewFileSystemCopyResource,
+		NewWormDomainResource,
+		NewWormSettingsResource,
+		NewDedupeSettingsResource,
+		NewStoragepoolTierResource,
+		NewStoragepoolNodepoolResource,
+		NewCloudPoolsAccountResource,
+		NewCloudPoolsPoolResource,
+		NewCloudPoolsSettingsResource,
+		NewLocalProviderResource,
+		NewFileProviderResource,
+		NewNISProviderResource,
+		NewKrb5RealmResource,
+		NewKrb5DomainResource,
+		NewKrb5ProviderResource,
+		NewKrb5SettingsResource,
+		NewAuthGlobalSettingsResource,
+		NewAuthMappingSettingsResource,
+		NewIdentityMappingResource,
 	}
 }
 
@@ -245,6 +464,23 @@ func (p *PscaleProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewSyncIQRuleDataSource,
 		NewSyncIQGlobalSettingsDataSource,
 		NewSyncIQPeerCertificateDataSource,
+		NewSyncIQTargetPolicyDataSource,
+		NewSyncIQReportDataSource,
+		NewSyncIQTargetReportDataSource,
+		NewJobDataSource,
+		NewQuotaReportDataSource,
+		NewFileDataSource,
+		NewDedupeSummaryDataSource,
+		NewDedupeReportDataSource,
+		NewStoragepoolDataSource,
+		NewLocalProviderDataSource,
+		NewFileProviderDataSource,
+		NewNISProviderDataSource,
+		NewAdsProviderDomainControllerDataSource,
+		NewAdsProviderTrustedDomainDataSource,
+		NewAuthGlobalSettingsDataSource,
+		NewAuthMappingSettingsDataSource,
+		NewIdentityMappingDataSource,
 	}
 }
 
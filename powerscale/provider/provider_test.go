@@ -142,6 +142,7 @@ func getClientForRegion(_ string) (*client.Client, error) {
 		powerscalePassword,
 		client.BasicAuthType,
 		2000,
+		client.Options{},
 	)
 	if err != nil {
 		return nil, err
@@ -343,6 +344,7 @@ func TestGetOnefsVersionErrorResponse(t *testing.T) {
 		"pass",
 		0,
 		300,
+		client.Options{},
 	)
 	if err != nil {
 		assert.Errorf(t, err, "NewOpenAPIClient failed")
@@ -367,6 +369,7 @@ func TestSetOnefsVersion(t *testing.T) {
 		"pass",
 		0,
 		300,
+		client.Options{},
 	)
 	if err != nil {
 		assert.Errorf(t, err, "NewOpenAPIClient failed")
@@ -390,6 +393,7 @@ func TestInsecureClientWithInsecureParam(t *testing.T) {
 		"pass",
 		0,
 		300,
+		client.Options{},
 	)
 	if err != nil {
 		assert.Errorf(t, err, "NewOpenAPIClient failed")
@@ -404,6 +408,7 @@ func TestInsecureClientWithInsecureParam(t *testing.T) {
 		"pass",
 		0,
 		300,
+		client.Options{},
 	)
 	if err != nil {
 		assert.Errorf(t, err, "NewOpenAPIClient failed")
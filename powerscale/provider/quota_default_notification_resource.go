@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &QuotaDefaultNotificationResource{}
+	_ resource.ResourceWithConfigure   = &QuotaDefaultNotificationResource{}
+	_ resource.ResourceWithImportState = &QuotaDefaultNotificationResource{}
+)
+
+// NewQuotaDefaultNotificationResource creates a new resource.
+func NewQuotaDefaultNotificationResource() resource.Resource {
+	return &QuotaDefaultNotificationResource{}
+}
+
+// QuotaDefaultNotificationResource defines the resource implementation.
+type QuotaDefaultNotificationResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaDefaultNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_default_notification"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaDefaultNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the global default quota notification rules on PowerScale Array. " +
+			"The default notification rules apply to all the quotas without notification rules of their own. " +
+			"We can Create, Update and Delete the default notification rules using this resource. We can also import an existing default notification rule from PowerScale array.",
+		Description: "This resource is used to manage the global default quota notification rules on PowerScale Array. " +
+			"The default notification rules apply to all the quotas without notification rules of their own. " +
+			"We can Create, Update and Delete the default notification rules using this resource. We can also import an existing default notification rule from PowerScale array.",
+		Attributes: quotaNotificationRuleAttributes(),
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaDefaultNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaDefaultNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating quota default notification")
+	var plan models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateQuotaDefaultNotification(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateQuotaDefaultNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota default notification", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Quota default notification %s created", id))
+
+	state := plan
+	if err := helper.GetQuotaDefaultNotificationState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadQuotaDefaultNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota default notification after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create quota default notification completed")
+}
+
+// Read reads the resource state.
+func (r *QuotaDefaultNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota default notification")
+	var state models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetQuotaDefaultNotificationState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadQuotaDefaultNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota default notification", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read quota default notification completed")
+}
+
+// Update updates the actions and the schedule of the default notification rule.
+func (r *QuotaDefaultNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating quota default notification")
+	var plan, state models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.UpdateQuotaDefaultNotification(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateQuotaDefaultNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota default notification", message)
+		return
+	}
+
+	if err := helper.GetQuotaDefaultNotificationState(ctx, r.client, plan.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadQuotaDefaultNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota default notification after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update quota default notification completed")
+}
+
+// Delete deletes the resource.
+func (r *QuotaDefaultNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota default notification")
+	var state models.QuotaDefaultNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteQuotaDefaultNotification(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteQuotaDefaultNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting quota default notification", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete quota default notification completed")
+}
+
+// ImportState imports the resource state.
+func (r *QuotaDefaultNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccQuotaDefaultNotificationResource tests the quota default notification resource.
+func TestAccQuotaDefaultNotificationResource(t *testing.T) {
+	resourceName := "powerscale_quota_default_notification.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccQuotaDefaultNotificationResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateQuotaDefaultNotification).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaDefaultNotificationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "threshold", "hard"),
+					resource.TestCheckResourceAttr(resourceName, "condition", "denied"),
+					resource.TestCheckResourceAttr(resourceName, "holdoff", "600"),
+					resource.TestCheckResourceAttr(resourceName, "action_alert", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccQuotaDefaultNotificationResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaDefaultNotification).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// update
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaDefaultNotificationResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "holdoff", "1200"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_owner", "true"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccQuotaDefaultNotificationResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetQuotaDefaultNotificationState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccQuotaDefaultNotificationResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteQuotaDefaultNotification).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaDefaultNotificationResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccQuotaDefaultNotificationResourceConfig = `
+resource "powerscale_quota_default_notification" "test" {
+	threshold = "hard"
+	condition = "denied"
+	holdoff = 600
+	action_alert = true
+}
+`
+
+var testAccQuotaDefaultNotificationResourceUpdateConfig = `
+resource "powerscale_quota_default_notification" "test" {
+	threshold = "hard"
+	condition = "denied"
+	holdoff = 1200
+	action_alert = true
+	action_email_owner = true
+}
+`
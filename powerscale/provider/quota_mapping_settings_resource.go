@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuotaMappingSettingsResource{}
+var _ resource.ResourceWithConfigure = &QuotaMappingSettingsResource{}
+var _ resource.ResourceWithImportState = &QuotaMappingSettingsResource{}
+
+// NewQuotaMappingSettingsResource creates a new resource.
+func NewQuotaMappingSettingsResource() resource.Resource {
+	return &QuotaMappingSettingsResource{}
+}
+
+// QuotaMappingSettingsResource defines the resource implementation.
+type QuotaMappingSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaMappingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_mapping_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaMappingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the quota mapping settings of PowerScale Array, which map the domains of the quota owners to the email domains used for the quota notifications. " +
+			"We can Create, Update and Delete the quota mapping settings using this resource. We can also import the existing quota mapping settings from PowerScale array. " +
+			"Note that, quota mapping settings is the native functionality of PowerScale. The configured mappings replace all the existing mappings, and deleting the resource only removes it from the state.",
+		Description: "This resource is used to manage the quota mapping settings of PowerScale Array, which map the domains of the quota owners to the email domains used for the quota notifications. " +
+			"We can Create, Update and Delete the quota mapping settings using this resource. We can also import the existing quota mapping settings from PowerScale array. " +
+			"Note that, quota mapping settings is the native functionality of PowerScale. The configured mappings replace all the existing mappings, and deleting the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"mappings": schema.ListNestedAttribute{
+				Description:         "The mappings of the domains to email domains. An empty list removes all the mappings.",
+				MarkdownDescription: "The mappings of the domains to email domains. An empty list removes all the mappings.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							Description:         "The FQDN of the source domain to map.",
+							MarkdownDescription: "The FQDN of the source domain to map.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"mapping": schema.StringAttribute{
+							Description:         "The FQDN of the destination domain to map to.",
+							MarkdownDescription: "The FQDN of the destination domain to map to.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							Description:         "The type of the source domain, ex. 'ad' or 'ldap'.",
+							MarkdownDescription: "The type of the source domain, ex. `ad` or `ldap`.",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaMappingSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	powerscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = powerscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaMappingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating quota mapping settings")
+
+	var plan models.QuotaMappingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateQuotaMappingSettings(ctx, r.client, plan); err != nil {
+		errStr := constants.UpdateQuotaMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota mapping settings", message)
+		return
+	}
+
+	mappingSettings, err := helper.GetQuotaMappingSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota mapping settings", message)
+		return
+	}
+
+	state := helper.QuotaMappingSettingsDetailMapper(mappingSettings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "create quota mapping settings completed")
+}
+
+// Read reads the resource state.
+func (r *QuotaMappingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading quota mapping settings")
+
+	var state models.QuotaMappingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappingSettings, err := helper.GetQuotaMappingSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota mapping settings", message)
+		return
+	}
+
+	state = helper.QuotaMappingSettingsDetailMapper(mappingSettings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "read quota mapping settings completed")
+}
+
+// Update updates the resource state.
+func (r *QuotaMappingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating quota mapping settings")
+
+	var plan models.QuotaMappingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateQuotaMappingSettings(ctx, r.client, plan); err != nil {
+		errStr := constants.UpdateQuotaMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota mapping settings", message)
+		return
+	}
+
+	mappingSettings, err := helper.GetQuotaMappingSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota mapping settings", message)
+		return
+	}
+
+	state := helper.QuotaMappingSettingsDetailMapper(mappingSettings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "update quota mapping settings completed")
+}
+
+// Delete deletes the resource.
+func (r *QuotaMappingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting quota mapping settings")
+
+	var state models.QuotaMappingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "delete quota mapping settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *QuotaMappingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	mappingSettings, err := helper.GetQuotaMappingSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaMappingSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing quota mapping settings", message)
+		return
+	}
+
+	state := helper.QuotaMappingSettingsDetailMapper(mappingSettings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "import quota mapping settings completed")
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAccQuotaMappingSettingsResource tests the quota mapping settings resource.
+func TestAccQuotaMappingSettingsResource(t *testing.T) {
+	resourceName := "powerscale_quota_mapping_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccQuotaMappingSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaMappingSettings).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaMappingSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mappings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mappings.0.domain", "tfacc.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "mappings.0.mapping", "mail.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "mappings.0.type"),
+				),
+			},
+			// import
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "1", states[0].Attributes["mappings.#"])
+					assert.Equal(t, "tfacc.example.com", states[0].Attributes["mappings.0.domain"])
+					return nil
+				},
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccQuotaMappingSettingsResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaMappingSettings).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// update
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaMappingSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mappings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "mappings.1.domain", "tfacc2.example.com"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccQuotaMappingSettingsResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetQuotaMappingSettings).Return(nil, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// remove all the mappings
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaMappingSettingsResourceEmptyConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mappings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccQuotaMappingSettingsResourceConfig = `
+resource "powerscale_quota_mapping_settings" "test" {
+	mappings = [
+		{
+			domain = "tfacc.example.com"
+			mapping = "mail.example.com"
+		},
+	]
+}
+`
+
+var testAccQuotaMappingSettingsResourceUpdateConfig = `
+resource "powerscale_quota_mapping_settings" "test" {
+	mappings = [
+		{
+			domain = "tfacc.example.com"
+			mapping = "mail.example.com"
+		},
+		{
+			domain = "tfacc2.example.com"
+			mapping = "mail.example.com"
+		},
+	]
+}
+`
+
+var testAccQuotaMappingSettingsResourceEmptyConfig = `
+resource "powerscale_quota_mapping_settings" "test" {
+	mappings = []
+}
+`
@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &QuotaNotificationResource{}
+	_ resource.ResourceWithConfigure   = &QuotaNotificationResource{}
+	_ resource.ResourceWithImportState = &QuotaNotificationResource{}
+)
+
+// NewQuotaNotificationResource creates a new resource.
+func NewQuotaNotificationResource() resource.Resource {
+	return &QuotaNotificationResource{}
+}
+
+// QuotaNotificationResource defines the resource implementation.
+type QuotaNotificationResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_notification"
+}
+
+// quotaNotificationRuleAttributes returns the attributes of a notification rule, shared by the quota and the default notification rules.
+func quotaNotificationRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The system ID given to the notification rule.",
+			MarkdownDescription: "The system ID given to the notification rule.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"threshold": schema.StringAttribute{
+			Required:            true,
+			Description:         "The quota threshold detected. Acceptable values: hard, soft, advisory. Cannot be updated.",
+			MarkdownDescription: "The quota threshold detected. Acceptable values: hard, soft, advisory. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("hard", "soft", "advisory"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"condition": schema.StringAttribute{
+			Required:            true,
+			Description:         "The condition detected. Acceptable values: exceeded, denied, violated, expired. Cannot be updated.",
+			MarkdownDescription: "The condition detected. Acceptable values: exceeded, denied, violated, expired. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("exceeded", "denied", "violated", "expired"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"schedule": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "The schedule of the notifications sent while the condition persists, ex. 'Every 1 days'. Only used with the exceeded and violated conditions.",
+			MarkdownDescription: "The schedule of the notifications sent while the condition persists, ex. `Every 1 days`. Only used with the exceeded and violated conditions.",
+		},
+		"holdoff": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Time to wait between detections, in seconds. Only used with the denied condition.",
+			MarkdownDescription: "Time to wait between detections, in seconds. Only used with the denied condition.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"action_alert": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Send alert when rule matches.",
+			MarkdownDescription: "Send alert when rule matches.",
+		},
+		"action_email_owner": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Email the quota owner when rule matches.",
+			MarkdownDescription: "Email the quota owner when rule matches.",
+		},
+		"action_email_address": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Description:         "Email addresses notified when rule matches.",
+			MarkdownDescription: "Email addresses notified when rule matches.",
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"email_template": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Path of the optional email template used for the notifications.",
+			MarkdownDescription: "Path of the optional email template used for the notifications.",
+		},
+	}
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := quotaNotificationRuleAttributes()
+	attributes["quota_id"] = schema.StringAttribute{
+		Required:            true,
+		Description:         "The ID of the quota the notification rule belongs to. Cannot be updated.",
+		MarkdownDescription: "The ID of the quota the notification rule belongs to. Cannot be updated.",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the notification rules of a quota on PowerScale Array. " +
+			"Once a quota has a notification rule, the global default notification rules no longer apply to it. " +
+			"We can Create, Update and Delete the quota notification rules using this resource. We can also import an existing quota notification rule from PowerScale array.",
+		Description: "This resource is used to manage the notification rules of a quota on PowerScale Array. " +
+			"Once a quota has a notification rule, the global default notification rules no longer apply to it. " +
+			"We can Create, Update and Delete the quota notification rules using this resource. We can also import an existing quota notification rule from PowerScale array.",
+		Attributes: attributes,
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating quota notification")
+	var plan models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateQuotaNotification(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateQuotaNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota notification", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Quota notification %s created", id))
+
+	state := plan
+	if err := helper.GetQuotaNotificationState(ctx, r.client, plan.QuotaID.ValueString(), id, &state); err != nil {
+		errStr := constants.ReadQuotaNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota notification after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create quota notification completed")
+}
+
+// Read reads the resource state.
+func (r *QuotaNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota notification")
+	var state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetQuotaNotificationState(ctx, r.client, state.QuotaID.ValueString(), state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadQuotaNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota notification", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read quota notification completed")
+}
+
+// Update updates the actions and the schedule of the notification rule.
+func (r *QuotaNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating quota notification")
+	var plan, state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.UpdateQuotaNotification(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateQuotaNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota notification", message)
+		return
+	}
+
+	if err := helper.GetQuotaNotificationState(ctx, r.client, plan.QuotaID.ValueString(), plan.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadQuotaNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota notification after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update quota notification completed")
+}
+
+// Delete deletes the resource.
+func (r *QuotaNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota notification")
+	var state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteQuotaNotification(ctx, r.client, state.QuotaID.ValueString(), state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteQuotaNotificationErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting quota notification", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete quota notification completed")
+}
+
+// ImportState imports the resource state.
+func (r *QuotaNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: quota_id:notification_id Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("quota_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccQuotaNotificationResource tests the quota notification resource.
+func TestAccQuotaNotificationResource(t *testing.T) {
+	resourceName := "powerscale_quota_notification.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccQuotaNotificationResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateQuotaNotification).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaNotificationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "quota_id", "powerscale_quota.quota_test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "soft"),
+					resource.TestCheckResourceAttr(resourceName, "condition", "exceeded"),
+					resource.TestCheckResourceAttr(resourceName, "schedule", "Every 1 days"),
+					resource.TestCheckResourceAttr(resourceName, "action_alert", "true"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_owner", "false"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_address.0", "tfacc@example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return rs.Primary.Attributes["quota_id"] + ":" + rs.Primary.ID, nil
+				},
+			},
+			// invalid import id
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile(`.*Unexpected Import Identifier.*`),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccQuotaNotificationResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaNotification).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// update
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaNotificationResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "schedule", "Every 2 days"),
+					resource.TestCheckResourceAttr(resourceName, "action_alert", "false"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_owner", "true"),
+					resource.TestCheckResourceAttr(resourceName, "action_email_address.#", "2"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccQuotaNotificationResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetQuotaNotificationState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccQuotaNotificationResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteQuotaNotification).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaNotificationResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccQuotaNotificationQuotaConfig = `
+resource "powerscale_quota" "quota_test" {
+	path = "/ifs/tfacc_quota_test"
+	type = "directory"
+	include_snapshots = false
+}
+`
+
+var testAccQuotaNotificationResourceConfig = testAccQuotaNotificationQuotaConfig + `
+resource "powerscale_quota_notification" "test" {
+	quota_id = powerscale_quota.quota_test.id
+	threshold = "soft"
+	condition = "exceeded"
+	schedule = "Every 1 days"
+	action_alert = true
+	action_email_owner = false
+	action_email_address = ["tfacc@example.com"]
+}
+`
+
+var testAccQuotaNotificationResourceUpdateConfig = testAccQuotaNotificationQuotaConfig + `
+resource "powerscale_quota_notification" "test" {
+	quota_id = powerscale_quota.quota_test.id
+	threshold = "soft"
+	condition = "exceeded"
+	schedule = "Every 2 days"
+	action_alert = false
+	action_email_owner = true
+	action_email_address = ["tfacc@example.com", "tfacc2@example.com"]
+}
+`
@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &QuotaReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &QuotaReportDataSource{}
+)
+
+// NewQuotaReportDataSource creates a new data source.
+func NewQuotaReportDataSource() datasource.DataSource {
+	return &QuotaReportDataSource{}
+}
+
+// QuotaReportDataSource defines the data source implementation.
+type QuotaReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *QuotaReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_report"
+}
+
+// Schema describes the data source arguments.
+func (d *QuotaReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the existing quota reports from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing quota reports from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"quota_reports": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of quota reports.",
+				MarkdownDescription: "List of quota reports.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The system ID given to the report.",
+							MarkdownDescription: "The system ID given to the report.",
+						},
+						"file": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Path of the report file.",
+							MarkdownDescription: "Path of the report file.",
+						},
+						"generated": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Method used to generate the report.",
+							MarkdownDescription: "Method used to generate the report.",
+						},
+						"time": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Time the report was created, in unix epoch seconds.",
+							MarkdownDescription: "Time the report was created, in unix epoch seconds.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Whether the report is a summary or a detailed report.",
+							MarkdownDescription: "Whether the report is a summary or a detailed report.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching quota reports.",
+				MarkdownDescription: "Filters for fetching quota reports.",
+				Attributes: map[string]schema.Attribute{
+					"generated": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list reports generated by this method.",
+						MarkdownDescription: "Only list reports generated by this method.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("manual", "scheduled", "all"),
+						},
+					},
+					"type": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list reports of this type.",
+						MarkdownDescription: "Only list reports of this type.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("summary", "detail", "all"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *QuotaReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *QuotaReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.QuotaReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports, err := helper.ListQuotaReports(ctx, d.client, data.Filter)
+	if err != nil {
+		errStr := constants.ListQuotaReportsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota reports", message)
+		return
+	}
+
+	state, err := helper.NewQuotaReportDataSource(ctx, reports)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map quota report fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccQuotaReportDataSource tests the quota report data source.
+func TestAccQuotaReportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// list all
+			{
+				Config: ProviderConfig + testAccQuotaReportDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_quota_report.all", "id", "quota_report_datasource"),
+					resource.TestCheckResourceAttrSet("data.powerscale_quota_report.all", "quota_reports.#"),
+				),
+			},
+			// filter
+			{
+				Config: ProviderConfig + testAccQuotaReportDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.powerscale_quota_report.manual", "quota_reports.0.id", "powerscale_quota_report.report", "id"),
+				),
+			},
+			// invalid filter
+			{
+				Config:      ProviderConfig + testAccQuotaReportDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			// list error
+			{
+				Config: ProviderConfig + testAccQuotaReportDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListQuotaReports).Return(nil, fmt.Errorf("mock list error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock list error.*`),
+			},
+			// mapping error
+			{
+				Config: ProviderConfig + testAccQuotaReportDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.NewQuotaReportDataSource).Return(nil, fmt.Errorf("mock mapping error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock mapping error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaReportDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccQuotaReportDataSourceConfig = `
+data "powerscale_quota_report" "all" {
+}
+`
+
+var testAccQuotaReportDataSourceFilterConfig = `
+resource "powerscale_quota_report" "report" {
+}
+
+data "powerscale_quota_report" "manual" {
+	filter {
+		generated = "manual"
+	}
+	depends_on = [powerscale_quota_report.report]
+}
+`
+
+var testAccQuotaReportDataSourceInvalidConfig = `
+data "powerscale_quota_report" "invalid" {
+	filter {
+		generated = "invalid"
+	}
+}
+`
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &QuotaReportResource{}
+	_ resource.ResourceWithConfigure   = &QuotaReportResource{}
+	_ resource.ResourceWithImportState = &QuotaReportResource{}
+)
+
+// NewQuotaReportResource creates a new resource.
+func NewQuotaReportResource() resource.Resource {
+	return &QuotaReportResource{}
+}
+
+// QuotaReportResource defines the resource implementation.
+type QuotaReportResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaReportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_report"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaReportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to generate an on-demand quota report on PowerScale Array. " +
+			"The report is written to the live reports directory of the quota report settings, and is removed from the state once it is rotated out by the retention of the manual reports. " +
+			"We can Create and Delete the quota reports using this resource. We can also import an existing quota report from PowerScale array.",
+		Description: "This resource is used to generate an on-demand quota report on PowerScale Array. " +
+			"The report is written to the live reports directory of the quota report settings, and is removed from the state once it is rotated out by the retention of the manual reports. " +
+			"We can Create and Delete the quota reports using this resource. We can also import an existing quota report from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the report.",
+				MarkdownDescription: "The system ID given to the report.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Path of the report file.",
+				MarkdownDescription: "Path of the report file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"generated": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Method used to generate the report.",
+				MarkdownDescription: "Method used to generate the report.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Time the report was created, in unix epoch seconds.",
+				MarkdownDescription: "Time the report was created, in unix epoch seconds.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Whether the report is a summary or a detailed report.",
+				MarkdownDescription: "Whether the report is a summary or a detailed report.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaReportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create generates the report.
+func (r *QuotaReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Generating quota report")
+	var plan models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateQuotaReport(ctx, r.client)
+	if err != nil {
+		errStr := constants.CreateQuotaReportErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error generating quota report", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Quota report %s generated", id))
+
+	report, err := helper.GetQuotaReport(ctx, r.client, id)
+	if err == nil && report == nil {
+		err = fmt.Errorf("could not find quota report %s", id)
+	}
+	if err != nil {
+		errStr := constants.ReadQuotaReportErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota report after create", message)
+		return
+	}
+
+	var state models.QuotaReportResourceModel
+	if err := helper.CopyFields(ctx, report, &state); err != nil {
+		resp.Diagnostics.AddError("Error reading quota report after create", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Generate quota report completed")
+}
+
+// Read reads the resource state.
+func (r *QuotaReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota report")
+	var state models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := helper.GetQuotaReport(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		errStr := constants.ReadQuotaReportErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota report", message)
+		return
+	}
+	if report == nil {
+		tflog.Info(ctx, fmt.Sprintf("Quota report %s no longer exists, removing it from the state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := helper.CopyFields(ctx, report, &state); err != nil {
+		resp.Diagnostics.AddError("Error reading quota report", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read quota report completed")
+}
+
+// Update is never called, as the report has no configurable attributes.
+func (r *QuotaReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete deletes the report.
+func (r *QuotaReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota report")
+	var state models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteQuotaReport(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteQuotaReportErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting quota report", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete quota report completed")
+}
+
+// ImportState imports the resource state.
+func (r *QuotaReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,295 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuotaReportSettingsResource{}
+var _ resource.ResourceWithConfigure = &QuotaReportSettingsResource{}
+var _ resource.ResourceWithImportState = &QuotaReportSettingsResource{}
+
+// NewQuotaReportSettingsResource creates a new resource.
+func NewQuotaReportSettingsResource() resource.Resource {
+	return &QuotaReportSettingsResource{}
+}
+
+// QuotaReportSettingsResource defines the resource implementation.
+type QuotaReportSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaReportSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_report_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaReportSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the quota report settings of PowerScale Array. We can Create, Update and Delete the quota report settings using this resource. " +
+			"We can also import the existing quota report settings from PowerScale array. Note that, quota report settings is the native functionality of PowerScale. When creating the resource, we actually load quota report settings from PowerScale to the resource state.",
+		Description: "This resource is used to manage the quota report settings of PowerScale Array. We can Create, Update and Delete the quota report settings using this resource. " +
+			"We can also import the existing quota report settings from PowerScale array. Note that, quota report settings is the native functionality of PowerScale. When creating the resource, we actually load quota report settings from PowerScale to the resource state.",
+		Attributes: map[string]schema.Attribute{
+			"live_dir": schema.StringAttribute{
+				Description:         "The directory on /ifs where manual or live reports will be placed.",
+				MarkdownDescription: "The directory on /ifs where manual or live reports will be placed.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"live_retain": schema.Int64Attribute{
+				Description:         "The number of manual reports to keep.",
+				MarkdownDescription: "The number of manual reports to keep.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description:         "The isidate schedule used to generate reports, ex. 'every day at 1:00'. An empty value disables the scheduled reports.",
+				MarkdownDescription: "The isidate schedule used to generate reports, ex. `every day at 1:00`. An empty value disables the scheduled reports.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"scheduled_dir": schema.StringAttribute{
+				Description:         "The directory on /ifs where schedule reports will be placed.",
+				MarkdownDescription: "The directory on /ifs where schedule reports will be placed.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scheduled_retain": schema.Int64Attribute{
+				Description:         "The number of scheduled reports to keep.",
+				MarkdownDescription: "The number of scheduled reports to keep.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaReportSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	powerscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = powerscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaReportSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "creating quota report settings")
+
+	var plan models.QuotaReportSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V1SettingsReportsExtended
+	// Get param from tf input
+	if err := helper.ReadFromState(ctx, plan, &toUpdate); err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error creating quota report settings",
+			fmt.Sprintf("Could not read quota report settings param with error: %s", message),
+		)
+		return
+	}
+	if err := helper.UpdateQuotaReportSettings(ctx, r.client, toUpdate); err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota report settings", message)
+		return
+	}
+
+	reportSettings, err := helper.GetQuotaReportSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating quota report settings", message)
+		return
+	}
+
+	var state models.QuotaReportSettingsResourceModel
+	if err := helper.CopyFields(ctx, reportSettings.Settings, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating quota report settings",
+			fmt.Sprintf("Could not read quota report settings struct with error: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "create quota report settings completed")
+}
+
+// Read reads the resource state.
+func (r *QuotaReportSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "reading quota report settings")
+
+	var state models.QuotaReportSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportSettings, err := helper.GetQuotaReportSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading quota report settings", message)
+		return
+	}
+
+	if err := helper.CopyFields(ctx, reportSettings.Settings, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading quota report settings",
+			fmt.Sprintf("Could not read quota report settings struct with error: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "read quota report settings completed")
+}
+
+// Update updates the resource state.
+func (r *QuotaReportSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "updating quota report settings")
+
+	var plan models.QuotaReportSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var toUpdate powerscale.V1SettingsReportsExtended
+	// Get param from tf input
+	if err := helper.ReadFromState(ctx, plan, &toUpdate); err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error updating quota report settings",
+			fmt.Sprintf("Could not read quota report settings param with error: %s", message),
+		)
+		return
+	}
+	if err := helper.UpdateQuotaReportSettings(ctx, r.client, toUpdate); err != nil {
+		errStr := constants.UpdateQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota report settings", message)
+		return
+	}
+
+	reportSettings, err := helper.GetQuotaReportSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating quota report settings", message)
+		return
+	}
+
+	var state models.QuotaReportSettingsResourceModel
+	if err := helper.CopyFields(ctx, reportSettings.Settings, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating quota report settings",
+			fmt.Sprintf("Could not read quota report settings struct with error: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "update quota report settings completed")
+}
+
+// Delete deletes the resource.
+func (r *QuotaReportSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "deleting quota report settings")
+
+	var state models.QuotaReportSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "delete quota report settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *QuotaReportSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	reportSettings, err := helper.GetQuotaReportSettings(ctx, r.client)
+	if err != nil {
+		errStr := constants.ReadQuotaReportSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing quota report settings", message)
+		return
+	}
+
+	var state models.QuotaReportSettingsResourceModel
+	if err := helper.CopyFields(ctx, reportSettings.Settings, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing quota report settings",
+			fmt.Sprintf("Could not read quota report settings struct with error: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	tflog.Info(ctx, "import quota report settings completed")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAccQuotaReportSettingsResource tests the quota report settings resource.
+func TestAccQuotaReportSettingsResource(t *testing.T) {
+	resourceName := "powerscale_quota_report_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccQuotaReportSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaReportSettings).Return(fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaReportSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "live_retain", "10"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_retain", "15"),
+					resource.TestCheckResourceAttrSet(resourceName, "live_dir"),
+					resource.TestCheckResourceAttrSet(resourceName, "scheduled_dir"),
+				),
+			},
+			// import
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, "10", states[0].Attributes["live_retain"])
+					assert.Equal(t, "15", states[0].Attributes["scheduled_retain"])
+					return nil
+				},
+			},
+			// import error
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetQuotaReportSettings).Return(nil, fmt.Errorf("mock import error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock import error.*`),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccQuotaReportSettingsResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaReportSettings).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// update
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaReportSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "live_retain", "5"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_retain", "7"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccQuotaReportSettingsResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetQuotaReportSettings).Return(nil, fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccQuotaReportSettingsResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccQuotaReportSettingsResourceConfig = `
+resource "powerscale_quota_report_settings" "test" {
+	live_retain = 10
+	scheduled_retain = 15
+}
+`
+
+var testAccQuotaReportSettingsResourceUpdateConfig = `
+resource "powerscale_quota_report_settings" "test" {
+	live_retain = 5
+	scheduled_retain = 7
+}
+`
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SnapshotAliasResource{}
+	_ resource.ResourceWithConfigure   = &SnapshotAliasResource{}
+	_ resource.ResourceWithImportState = &SnapshotAliasResource{}
+)
+
+// NewSnapshotAliasResource creates a new resource.
+func NewSnapshotAliasResource() resource.Resource {
+	return &SnapshotAliasResource{}
+}
+
+// SnapshotAliasResource defines the resource implementation.
+type SnapshotAliasResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SnapshotAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_alias"
+}
+
+// Schema describes the resource arguments.
+func (r *SnapshotAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the snapshot aliases on PowerScale Array. " +
+			"A snapshot alias is a name which points to a snapshot, and can be retargeted to a different snapshot without changing its name. " +
+			"We can Create, Update and Delete the snapshot aliases using this resource. We can also import an existing snapshot alias from PowerScale array.",
+		Description: "This resource is used to manage the snapshot aliases on PowerScale Array. " +
+			"A snapshot alias is a name which points to a snapshot, and can be retargeted to a different snapshot without changing its name. " +
+			"We can Create, Update and Delete the snapshot aliases using this resource. We can also import an existing snapshot alias from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the snapshot alias.",
+				MarkdownDescription: "The system ID given to the snapshot alias.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The user supplied snapshot alias name.",
+				MarkdownDescription: "The user supplied snapshot alias name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name or ID of the snapshot the alias points to. Updating this value retargets the alias.",
+				MarkdownDescription: "The name or ID of the snapshot the alias points to. Updating this value retargets the alias.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The ID of the snapshot pointed to by the alias.",
+				MarkdownDescription: "The ID of the snapshot pointed to by the alias.",
+			},
+			"target_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the snapshot pointed to by the alias.",
+				MarkdownDescription: "The name of the snapshot pointed to by the alias.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SnapshotAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SnapshotAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating snapshot alias")
+	var plan models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateSnapshotAlias(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateSnapshotAliasErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating snapshot alias", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Snapshot alias %s created", id))
+
+	state := plan
+	if err := helper.GetSnapshotAliasState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadSnapshotAliasErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot alias after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create snapshot alias completed")
+}
+
+// Read reads the resource state.
+func (r *SnapshotAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot alias")
+	var state models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetSnapshotAliasState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadSnapshotAliasErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot alias", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read snapshot alias completed")
+}
+
+// Update renames and/or retargets the snapshot alias.
+func (r *SnapshotAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating snapshot alias")
+	var plan, state models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := helper.UpdateSnapshotAlias(ctx, r.client, id, &plan, &state); err != nil {
+		errStr := constants.UpdateSnapshotAliasErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating snapshot alias", message)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.GetSnapshotAliasState(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.ReadSnapshotAliasErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot alias after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update snapshot alias completed")
+}
+
+// Delete deletes the resource.
+func (r *SnapshotAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting snapshot alias")
+	var state models.SnapshotAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteSnapshotAlias(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteSnapshotAliasErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting snapshot alias", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete snapshot alias completed")
+}
+
+// ImportState imports the resource state.
+func (r *SnapshotAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSnapshotAliasResource tests the snapshot alias resource.
+func TestAccSnapshotAliasResource(t *testing.T) {
+	resourceName := "powerscale_snapshot_alias.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccSnapshotAliasResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateSnapshotAlias).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSnapshotAliasResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_snapshot_alias"),
+					resource.TestCheckResourceAttr(resourceName, "target", "tfacc_alias_snap_1"),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "tfacc_alias_snap_1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "powerscale_snapshot.snap1", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccSnapshotAliasResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateSnapshotAlias).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// retarget and rename
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSnapshotAliasResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_snapshot_alias_latest"),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "tfacc_alias_snap_2"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "powerscale_snapshot.snap2", "id"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccSnapshotAliasResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetSnapshotAliasState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccSnapshotAliasResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteSnapshotAlias).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSnapshotAliasResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccSnapshotAliasSnapshotsConfig = `
+resource "powerscale_snapshot" "snap1" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_alias_snap_1"
+}
+
+resource "powerscale_snapshot" "snap2" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_alias_snap_2"
+}
+`
+
+var testAccSnapshotAliasResourceConfig = testAccSnapshotAliasSnapshotsConfig + `
+resource "powerscale_snapshot_alias" "test" {
+	name = "tfacc_snapshot_alias"
+	target = powerscale_snapshot.snap1.name
+}
+`
+
+var testAccSnapshotAliasResourceUpdateConfig = testAccSnapshotAliasSnapshotsConfig + `
+resource "powerscale_snapshot_alias" "test" {
+	name = "tfacc_snapshot_alias_latest"
+	target = powerscale_snapshot.snap2.id
+}
+`
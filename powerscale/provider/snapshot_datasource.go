@@ -20,13 +20,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -158,6 +162,48 @@ func (d *SnapshotDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 					"path": schema.StringAttribute{
 						Optional: true,
 					},
+					"schedule": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list snapshots created by this schedule.",
+						MarkdownDescription: "Only list snapshots created by this schedule.",
+					},
+					"state": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list snapshots matching this state.",
+						MarkdownDescription: "Only list snapshots matching this state.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "active", "deleting"),
+						},
+					},
+					"type": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list snapshots matching this type.",
+						MarkdownDescription: "Only list snapshots matching this type.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "alias", "real"),
+						},
+					},
+					"sort": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The field that will be used for sorting.",
+						MarkdownDescription: "The field that will be used for sorting.",
+					},
+					"dir": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The direction of the sort.",
+						MarkdownDescription: "The direction of the sort.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("ASC", "DESC"),
+						},
+					},
+					"limit": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "Return no more than this many results. If not set, all the snapshots are fetched.",
+						MarkdownDescription: "Return no more than this many results. If not set, all the snapshots are fetched.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, math.MaxInt32),
+						},
+					},
 				},
 			},
 		},
@@ -196,7 +242,7 @@ func (d *SnapshotDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	result, err := helper.GetAllSnapshots(ctx, d.client)
+	result, err := helper.GetAllSnapshots(ctx, d.client, plan.SnapshotFilter)
 	if err != nil {
 		errStr := constants.ReadSnapshotErrorMessage + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -243,6 +289,7 @@ func (d *SnapshotDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 	// save into the Terraform state.
 	state.ID = types.StringValue("snapshot_datasource")
+	state.SnapshotFilter = plan.SnapshotFilter
 
 	tflog.Trace(ctx, "read the snapshot datasource")
 
@@ -66,6 +66,35 @@ func TestAccSnapshotDataSourceAll(t *testing.T) {
 	})
 }
 
+func TestAccSnapshotDataSourceServerFilter(t *testing.T) {
+	var snapshotTerraformName = "data.powerscale_snapshot.filtered"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// server side filter testing
+			{
+				Config: ProviderConfig + SnapshotServerFilterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotTerraformName, "snapshots_details.#", "1"),
+					resource.TestCheckResourceAttr(snapshotTerraformName, "snapshots_details.0.state", "active"),
+				),
+			},
+			// invalid filter value
+			{
+				Config: ProviderConfig + `
+				data "powerscale_snapshot" "filtered" {
+					filter {
+						type = "invalid"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+		},
+	})
+}
+
 func TestAccSnapshotDataSourceGetErr(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -113,3 +142,15 @@ var SnapshotAllDataSourceConfig = `
 data "powerscale_snapshot" "all" {
 }
 `
+
+var SnapshotServerFilterDataSourceConfig = `
+data "powerscale_snapshot" "filtered" {
+  filter {
+    state = "active"
+    type = "real"
+    sort = "created"
+    dir = "DESC"
+    limit = 1
+  }
+}
+`
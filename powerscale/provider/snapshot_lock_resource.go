@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SnapshotLockResource{}
+	_ resource.ResourceWithConfigure   = &SnapshotLockResource{}
+	_ resource.ResourceWithImportState = &SnapshotLockResource{}
+)
+
+// NewSnapshotLockResource creates a new resource.
+func NewSnapshotLockResource() resource.Resource {
+	return &SnapshotLockResource{}
+}
+
+// SnapshotLockResource defines the resource implementation.
+type SnapshotLockResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SnapshotLockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_lock"
+}
+
+// Schema describes the resource arguments.
+func (r *SnapshotLockResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the locks of a snapshot on PowerScale Array. " +
+			"A locked snapshot cannot be deleted until all its locks are removed or expired. " +
+			"We can Create, Update and Delete the snapshot locks using this resource. We can also import an existing snapshot lock from PowerScale array.",
+		Description: "This resource is used to manage the locks of a snapshot on PowerScale Array. " +
+			"A locked snapshot cannot be deleted until all its locks are removed or expired. " +
+			"We can Create, Update and Delete the snapshot locks using this resource. We can also import an existing snapshot lock from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the lock.",
+				MarkdownDescription: "The system ID given to the lock.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name or ID of the snapshot to lock. Cannot be updated.",
+				MarkdownDescription: "The name or ID of the snapshot to lock. Cannot be updated.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "User supplied lock comment.",
+				MarkdownDescription: "User supplied lock comment.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The Unix Epoch time the lock will expire and be eligible for automatic deletion. If not set, the lock never expires. Cannot be updated.",
+				MarkdownDescription: "The Unix Epoch time the lock will expire and be eligible for automatic deletion. If not set, the lock never expires. Cannot be updated.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"lock_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of times this lock has been created.",
+				MarkdownDescription: "The number of times this lock has been created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SnapshotLockResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SnapshotLockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating snapshot lock")
+	var plan models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateSnapshotLock(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateSnapshotLockErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating snapshot lock", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Snapshot lock %s created", id))
+
+	state := plan
+	if err := helper.GetSnapshotLockState(ctx, r.client, plan.SnapshotID.ValueString(), id, &state); err != nil {
+		errStr := constants.ReadSnapshotLockErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot lock after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create snapshot lock completed")
+}
+
+// Read reads the resource state.
+func (r *SnapshotLockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading snapshot lock")
+	var state models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetSnapshotLockState(ctx, r.client, state.SnapshotID.ValueString(), state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadSnapshotLockErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot lock", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read snapshot lock completed")
+}
+
+// Update updates the comment of the snapshot lock.
+func (r *SnapshotLockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating snapshot lock")
+	var plan, state models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.UpdateSnapshotLock(ctx, r.client, &plan); err != nil {
+		errStr := constants.UpdateSnapshotLockErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating snapshot lock", message)
+		return
+	}
+
+	if err := helper.GetSnapshotLockState(ctx, r.client, plan.SnapshotID.ValueString(), plan.ID.ValueString(), &plan); err != nil {
+		errStr := constants.ReadSnapshotLockErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading snapshot lock after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update snapshot lock completed")
+}
+
+// Delete deletes the resource.
+func (r *SnapshotLockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting snapshot lock")
+	var state models.SnapshotLockResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteSnapshotLock(ctx, r.client, state.SnapshotID.ValueString(), state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteSnapshotLockErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting snapshot lock", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete snapshot lock completed")
+}
+
+// ImportState imports the resource state.
+func (r *SnapshotLockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: snapshot_id:lock_id Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("snapshot_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
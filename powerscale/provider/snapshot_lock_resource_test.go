@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccSnapshotLockResource tests the snapshot lock resource.
+func TestAccSnapshotLockResource(t *testing.T) {
+	resourceName := "powerscale_snapshot_lock.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccSnapshotLockResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateSnapshotLock).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSnapshotLockResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "tfacc lock"),
+					resource.TestCheckResourceAttr(resourceName, "lock_count", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", "powerscale_snapshot.snap", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return rs.Primary.Attributes["snapshot_id"] + ":" + rs.Primary.ID, nil
+				},
+			},
+			// invalid import id
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile(`.*Unexpected Import Identifier.*`),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccSnapshotLockResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateSnapshotLock).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// update
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSnapshotLockResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "tfacc lock updated"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccSnapshotLockResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetSnapshotLockState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccSnapshotLockResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteSnapshotLock).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSnapshotLockResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccSnapshotLockSnapshotConfig = `
+resource "powerscale_snapshot" "snap" {
+	path = "/ifs/tfacc_file_system_test"
+	name = "tfacc_lock_snap"
+}
+`
+
+var testAccSnapshotLockResourceConfig = testAccSnapshotLockSnapshotConfig + `
+resource "powerscale_snapshot_lock" "test" {
+	snapshot_id = powerscale_snapshot.snap.id
+	comment = "tfacc lock"
+}
+`
+
+var testAccSnapshotLockResourceUpdateConfig = testAccSnapshotLockSnapshotConfig + `
+resource "powerscale_snapshot_lock" "test" {
+	snapshot_id = powerscale_snapshot.snap.id
+	comment = "tfacc lock updated"
+}
+`
@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &StoragepoolDataSource{}
+	_ datasource.DataSourceWithConfigure = &StoragepoolDataSource{}
+)
+
+// NewStoragepoolDataSource creates a new data source.
+func NewStoragepoolDataSource() datasource.DataSource {
+	return &StoragepoolDataSource{}
+}
+
+// StoragepoolDataSource defines the data source implementation.
+type StoragepoolDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *StoragepoolDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storagepool"
+}
+
+// Schema describes the data source arguments.
+func (d *StoragepoolDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the existing node pools and tiers, with their usage, from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description:         "This datasource is used to query the existing node pools and tiers, with their usage, from PowerScale array. The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"storagepools": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of storage pools.",
+				MarkdownDescription: "List of storage pools.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"children": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "The names of the node pools in the tier.",
+							MarkdownDescription: "The names of the node pools in the tier.",
+						},
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The system ID given to the storage pool.",
+							MarkdownDescription: "The system ID given to the storage pool.",
+						},
+						"l3": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the SSDs of the node pool are used as L3 cache.",
+							MarkdownDescription: "Whether the SSDs of the node pool are used as L3 cache.",
+						},
+						"lnns": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							Description:         "The nodes of the storage pool.",
+							MarkdownDescription: "The nodes of the storage pool.",
+						},
+						"manual": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the node pool is a manual node pool.",
+							MarkdownDescription: "Whether the node pool is a manual node pool.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The storage pool name.",
+							MarkdownDescription: "The storage pool name.",
+						},
+						"protection_policy": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The protection policy of the node pool.",
+							MarkdownDescription: "The protection policy of the node pool.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Whether the storage pool is a nodepool or a tier.",
+							MarkdownDescription: "Whether the storage pool is a nodepool or a tier.",
+						},
+						"usage": schema.SingleNestedAttribute{
+							Computed:            true,
+							Description:         "The usage of the storage pool.",
+							MarkdownDescription: "The usage of the storage pool.",
+							Attributes: map[string]schema.Attribute{
+								"avail_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Available bytes in the storage pool.",
+									MarkdownDescription: "Available bytes in the storage pool.",
+								},
+								"avail_ssd_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Available SSD bytes in the storage pool.",
+									MarkdownDescription: "Available SSD bytes in the storage pool.",
+								},
+								"balanced": schema.BoolAttribute{
+									Computed:            true,
+									Description:         "Whether the storage pool is balanced.",
+									MarkdownDescription: "Whether the storage pool is balanced.",
+								},
+								"free_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Free bytes in the storage pool.",
+									MarkdownDescription: "Free bytes in the storage pool.",
+								},
+								"free_ssd_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Free SSD bytes in the storage pool.",
+									MarkdownDescription: "Free SSD bytes in the storage pool.",
+								},
+								"pct_used": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Percentage of the storage pool used.",
+									MarkdownDescription: "Percentage of the storage pool used.",
+								},
+								"total_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Total bytes in the storage pool.",
+									MarkdownDescription: "Total bytes in the storage pool.",
+								},
+								"total_ssd_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Total SSD bytes in the storage pool.",
+									MarkdownDescription: "Total SSD bytes in the storage pool.",
+								},
+								"used_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Used bytes in the storage pool.",
+									MarkdownDescription: "Used bytes in the storage pool.",
+								},
+								"used_ssd_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Used SSD bytes in the storage pool.",
+									MarkdownDescription: "Used SSD bytes in the storage pool.",
+								},
+								"virtual_hot_spare_bytes": schema.StringAttribute{
+									Computed:            true,
+									Description:         "Bytes reserved for virtual hot spare in the storage pool.",
+									MarkdownDescription: "Bytes reserved for virtual hot spare in the storage pool.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching storage pools.",
+				MarkdownDescription: "Filters for fetching storage pools.",
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Only list the storage pools with these names.",
+						MarkdownDescription: "Only list the storage pools with these names.",
+					},
+					"type": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list the storage pools of this type.",
+						MarkdownDescription: "Only list the storage pools of this type.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("nodepool", "tier"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *StoragepoolDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *StoragepoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.StoragepoolDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storagepools, err := helper.ListStoragepools(ctx, d.client)
+	if err != nil {
+		errStr := constants.ListStoragepoolsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading storage pools", message)
+		return
+	}
+
+	state, err := helper.NewStoragepoolDataSource(ctx, storagepools, data.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map storage pool fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccStoragepoolDataSource tests the storage pool data source.
+func TestAccStoragepoolDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// list all
+			{
+				Config: ProviderConfig + testAccStoragepoolDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_storagepool.all", "id", "storagepool_datasource"),
+					resource.TestCheckResourceAttrSet("data.powerscale_storagepool.all", "storagepools.#"),
+					resource.TestCheckResourceAttrSet("data.powerscale_storagepool.all", "storagepools.0.usage.total_bytes"),
+				),
+			},
+			// filter
+			{
+				Config: ProviderConfig + testAccStoragepoolDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_storagepool.filtered", "storagepools.#", "1"),
+					resource.TestCheckResourceAttrPair("data.powerscale_storagepool.filtered", "storagepools.0.name", "data.powerscale_storagepool.all", "storagepools.0.name"),
+				),
+			},
+			// invalid filter
+			{
+				Config:      ProviderConfig + testAccStoragepoolDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*could not find storage pool.*`),
+			},
+			// list error
+			{
+				Config: ProviderConfig + testAccStoragepoolDataSourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListStoragepools).Return(nil, fmt.Errorf("mock list error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock list error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccStoragepoolDataSourceConfig,
+			},
+		},
+	})
+}
+
+var testAccStoragepoolDataSourceConfig = `
+data "powerscale_storagepool" "all" {
+}
+`
+
+var testAccStoragepoolDataSourceFilterConfig = testAccStoragepoolDataSourceConfig + `
+data "powerscale_storagepool" "filtered" {
+	filter {
+		names = [data.powerscale_storagepool.all.storagepools.0.name]
+	}
+}
+`
+
+var testAccStoragepoolDataSourceInvalidConfig = `
+data "powerscale_storagepool" "invalid" {
+	filter {
+		names = ["tfacc_invalid_storagepool"]
+	}
+}
+`
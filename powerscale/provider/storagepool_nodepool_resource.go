@@ -0,0 +1,298 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &StoragepoolNodepoolResource{}
+	_ resource.ResourceWithConfigure   = &StoragepoolNodepoolResource{}
+	_ resource.ResourceWithImportState = &StoragepoolNodepoolResource{}
+)
+
+// NewStoragepoolNodepoolResource creates a new resource.
+func NewStoragepoolNodepoolResource() resource.Resource {
+	return &StoragepoolNodepoolResource{}
+}
+
+// StoragepoolNodepoolResource defines the resource implementation.
+type StoragepoolNodepoolResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *StoragepoolNodepoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storagepool_nodepool"
+}
+
+// Schema describes the resource arguments.
+func (r *StoragepoolNodepoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the node pools on PowerScale Array. " +
+			"When lnns is configured a manual node pool is created with these nodes, otherwise the existing node pool with the given name is managed. " +
+			"Deleting the resource deletes manual node pools, while automatically provisioned node pools are only removed from the state. " +
+			"We can also import an existing node pool from PowerScale array.",
+		Description: "This resource is used to manage the node pools on PowerScale Array. " +
+			"When lnns is configured a manual node pool is created with these nodes, otherwise the existing node pool with the given name is managed. " +
+			"Deleting the resource deletes manual node pools, while automatically provisioned node pools are only removed from the state. " +
+			"We can also import an existing node pool from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the node pool.",
+				MarkdownDescription: "The system ID given to the node pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The node pool name.",
+				MarkdownDescription: "The node pool name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"lnns": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Description:         "The nodes of a manual node pool. Updating this value recreates the node pool.",
+				MarkdownDescription: "The nodes of a manual node pool. Updating this value recreates the node pool.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+					listplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"protection_policy": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The protection policy of the node pool, such as +2d:1n.",
+				MarkdownDescription: "The protection policy of the node pool, such as +2d:1n.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"l3": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the SSDs of the node pool are used as L3 cache.",
+				MarkdownDescription: "Whether the SSDs of the node pool are used as L3 cache.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transfer_limit_pct": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The percentage of the node pool capacity that SmartPools will fill before transferring data to other pools.",
+				MarkdownDescription: "The percentage of the node pool capacity that SmartPools will fill before transferring data to other pools.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+					int64validator.ConflictsWith(path.MatchRoot("transfer_limit_state")),
+				},
+			},
+			"transfer_limit_state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "How the transfer limit of the node pool is applied. Acceptable values: default, disabled.",
+				MarkdownDescription: "How the transfer limit of the node pool is applied. Acceptable values: default, disabled.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "disabled"),
+				},
+			},
+			"manual": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether the node pool is a manual node pool.",
+				MarkdownDescription: "Whether the node pool is a manual node pool.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tier": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the tier the node pool belongs to.",
+				MarkdownDescription: "The ID of the tier the node pool belongs to.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *StoragepoolNodepoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create creates a manual node pool, or takes over the existing node pool with the planned name.
+func (r *StoragepoolNodepoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating node pool")
+	var plan models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateStoragepoolNodepool(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating node pool", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Node pool %s created", id))
+
+	// the existing node pool is updated to the planned settings
+	var current models.StoragepoolNodepoolResourceModel
+	if err := helper.GetStoragepoolNodepoolState(ctx, r.client, id, &current); err != nil {
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading node pool after create", message)
+		return
+	}
+	if err := helper.UpdateStoragepoolNodepool(ctx, r.client, id, &plan, &current); err != nil {
+		errStr := constants.UpdateStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating node pool", message)
+		return
+	}
+
+	state := plan
+	if err := helper.GetStoragepoolNodepoolState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading node pool after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create node pool completed")
+}
+
+// Read reads the resource state.
+func (r *StoragepoolNodepoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading node pool")
+	var state models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetStoragepoolNodepoolState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading node pool", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read node pool completed")
+}
+
+// Update updates the resource state.
+func (r *StoragepoolNodepoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating node pool")
+	var plan, state models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := helper.UpdateStoragepoolNodepool(ctx, r.client, id, &plan, &state); err != nil {
+		errStr := constants.UpdateStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating node pool", message)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.GetStoragepoolNodepoolState(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.ReadStoragepoolNodepoolErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading node pool after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update node pool completed")
+}
+
+// Delete deletes manual node pools, and removes automatically provisioned node pools from the state.
+func (r *StoragepoolNodepoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting node pool")
+	var state models.StoragepoolNodepoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Manual.ValueBool() {
+		if err := helper.DeleteStoragepoolNodepool(ctx, r.client, state.ID.ValueString()); err != nil {
+			errStr := constants.DeleteStoragepoolNodepoolErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error deleting node pool", message)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete node pool completed")
+}
+
+// ImportState imports the resource state.
+func (r *StoragepoolNodepoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccStoragepoolNodepoolResource tests the node pool resource over an existing node pool.
+func TestAccStoragepoolNodepoolResource(t *testing.T) {
+	resourceName := "powerscale_storagepool_nodepool.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccStoragepoolNodepoolResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateStoragepoolNodepool).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccStoragepoolNodepoolResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "name", "data.powerscale_storagepool.nodepools", "storagepools.0.name"),
+					resource.TestCheckResourceAttr(resourceName, "manual", "false"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_limit_state", "disabled"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccStoragepoolNodepoolResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_limit_state", "default"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccStoragepoolNodepoolResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateStoragepoolNodepool).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccStoragepoolNodepoolResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetStoragepoolNodepoolState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccStoragepoolNodepoolResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccStoragepoolNodepoolDataConfig = `
+data "powerscale_storagepool" "nodepools" {
+	filter {
+		type = "nodepool"
+	}
+}
+`
+
+var testAccStoragepoolNodepoolResourceConfig = testAccStoragepoolNodepoolDataConfig + `
+resource "powerscale_storagepool_nodepool" "test" {
+	name = data.powerscale_storagepool.nodepools.storagepools.0.name
+	transfer_limit_state = "disabled"
+}
+`
+
+var testAccStoragepoolNodepoolResourceUpdateConfig = testAccStoragepoolNodepoolDataConfig + `
+resource "powerscale_storagepool_nodepool" "test" {
+	name = data.powerscale_storagepool.nodepools.storagepools.0.name
+	transfer_limit_state = "default"
+}
+`
@@ -0,0 +1,248 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &StoragepoolTierResource{}
+	_ resource.ResourceWithConfigure   = &StoragepoolTierResource{}
+	_ resource.ResourceWithImportState = &StoragepoolTierResource{}
+)
+
+// NewStoragepoolTierResource creates a new resource.
+func NewStoragepoolTierResource() resource.Resource {
+	return &StoragepoolTierResource{}
+}
+
+// StoragepoolTierResource defines the resource implementation.
+type StoragepoolTierResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *StoragepoolTierResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storagepool_tier"
+}
+
+// Schema describes the resource arguments.
+func (r *StoragepoolTierResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the storage pool tiers on PowerScale Array. " +
+			"A tier groups node pools, and can be used as the storage pool target of the file pool policies. " +
+			"We can Create, Update and Delete the storage pool tiers using this resource. We can also import an existing storage pool tier from PowerScale array.",
+		Description: "This resource is used to manage the storage pool tiers on PowerScale Array. " +
+			"A tier groups node pools, and can be used as the storage pool target of the file pool policies. " +
+			"We can Create, Update and Delete the storage pool tiers using this resource. We can also import an existing storage pool tier from PowerScale array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the tier.",
+				MarkdownDescription: "The system ID given to the tier.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The tier name.",
+				MarkdownDescription: "The tier name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"children": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The names of the node pools in the tier.",
+				MarkdownDescription: "The names of the node pools in the tier.",
+			},
+			"transfer_limit_pct": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The percentage of the tier capacity that SmartPools will fill before transferring data to other pools.",
+				MarkdownDescription: "The percentage of the tier capacity that SmartPools will fill before transferring data to other pools.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+					int64validator.ConflictsWith(path.MatchRoot("transfer_limit_state")),
+				},
+			},
+			"transfer_limit_state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "How the transfer limit of the tier is applied. Acceptable values: default, disabled.",
+				MarkdownDescription: "How the transfer limit of the tier is applied. Acceptable values: default, disabled.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "disabled"),
+				},
+			},
+			"lnns": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Description:         "The nodes of the tier.",
+				MarkdownDescription: "The nodes of the tier.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *StoragepoolTierResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *StoragepoolTierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating storage pool tier")
+	var plan models.StoragepoolTierResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateStoragepoolTier(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateStoragepoolTierErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating storage pool tier", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Storage pool tier %s created", id))
+
+	state := plan
+	if err := helper.GetStoragepoolTierState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadStoragepoolTierErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading storage pool tier after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create storage pool tier completed")
+}
+
+// Read reads the resource state.
+func (r *StoragepoolTierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading storage pool tier")
+	var state models.StoragepoolTierResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetStoragepoolTierState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadStoragepoolTierErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading storage pool tier", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read storage pool tier completed")
+}
+
+// Update updates the resource state.
+func (r *StoragepoolTierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating storage pool tier")
+	var plan, state models.StoragepoolTierResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := helper.UpdateStoragepoolTier(ctx, r.client, id, &plan, &state); err != nil {
+		errStr := constants.UpdateStoragepoolTierErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating storage pool tier", message)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.GetStoragepoolTierState(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.ReadStoragepoolTierErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading storage pool tier after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update storage pool tier completed")
+}
+
+// Delete deletes the resource.
+func (r *StoragepoolTierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting storage pool tier")
+	var state models.StoragepoolTierResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteStoragepoolTier(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteStoragepoolTierErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting storage pool tier", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete storage pool tier completed")
+}
+
+// ImportState imports the resource state.
+func (r *StoragepoolTierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccStoragepoolTierResource tests the storage pool tier resource.
+func TestAccStoragepoolTierResource(t *testing.T) {
+	resourceName := "powerscale_storagepool_tier.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid transfer limit
+			{
+				Config:      ProviderConfig + testAccStoragepoolTierResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccStoragepoolTierResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateStoragepoolTier).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccStoragepoolTierResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_tier"),
+					resource.TestCheckResourceAttr(resourceName, "children.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_limit_state", "disabled"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + testAccStoragepoolTierResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tfacc_tier_updated"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_limit_pct", "80"),
+				),
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccStoragepoolTierResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateStoragepoolTier).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccStoragepoolTierResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetStoragepoolTierState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccStoragepoolTierResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccStoragepoolTierResourceConfig = `
+resource "powerscale_storagepool_tier" "test" {
+	name = "tfacc_tier"
+	transfer_limit_state = "disabled"
+}
+`
+
+var testAccStoragepoolTierResourceUpdateConfig = `
+resource "powerscale_storagepool_tier" "test" {
+	name = "tfacc_tier_updated"
+	transfer_limit_pct = 80
+}
+`
+
+var testAccStoragepoolTierResourceInvalidConfig = `
+resource "powerscale_storagepool_tier" "test" {
+	name = "tfacc_tier"
+	transfer_limit_pct = 80
+	transfer_limit_state = "disabled"
+}
+`
@@ -25,6 +25,7 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -208,6 +209,13 @@ func (r *SupportAssistResource) Schema(ctx context.Context, req resource.SchemaR
 		MarkdownDescription: "This resource is used to manage the Support Assist settings of PowerScale Array. We can Create, Update and Delete the Support Assist settings using this resource. Note that, Support Assist settings is the native functionality of PowerScale.",
 		Description:         "This resource is used to manage the Support Assist settings of PowerScale Array. We can Create, Update and Delete the Support Assist settings using this resource. Note that, Support Assist settings is the native functionality of PowerScale.",
 		Attributes:          SupportAssistResourceSchema(),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -557,6 +565,14 @@ func (r *SupportAssistResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultResourceTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	state, diags := helper.ManageSupportAssist(ctx, r.client, plan)
 	response.Diagnostics.Append(diags...)
 
@@ -598,7 +614,15 @@ func (r *SupportAssistResource) Update(ctx context.Context, request resource.Upd
 		return
 	}
 
-	state, diags := helper.ManageSupportAssist(ctx, r.client, plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helper.DefaultResourceTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	state, diags = helper.ManageSupportAssist(ctx, r.client, plan)
 	response.Diagnostics.Append(diags...)
 
 	// Save updated data into Terraform state
@@ -0,0 +1,311 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &SyncIQJobResource{}
+	_ resource.ResourceWithConfigure = &SyncIQJobResource{}
+)
+
+// NewSyncIQJobResource creates a new resource.
+func NewSyncIQJobResource() resource.Resource {
+	return &SyncIQJobResource{}
+}
+
+// SyncIQJobResource defines the resource implementation.
+type SyncIQJobResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SyncIQJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_job"
+}
+
+// Schema describes the resource arguments.
+func (r *SyncIQJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to run a SyncIQ job on PowerScale Array. " +
+			"Creating this resource starts a SyncIQ job for a policy with the given action, such as run, resync_prep, allow_write, allow_write_revert or test, " +
+			"and optionally waits for the job to complete and reports the outcome of the job. " +
+			"Changing any of the job parameters starts a new job. Deleting this resource only removes it from the Terraform state, it does not revert the job.",
+		Description: "This resource is used to run a SyncIQ job on PowerScale Array. " +
+			"Creating this resource starts a SyncIQ job for a policy with the given action, such as run, resync_prep, allow_write, allow_write_revert or test, " +
+			"and optionally waits for the job to complete and reports the outcome of the job. " +
+			"Changing any of the job parameters starts a new job. Deleting this resource only removes it from the Terraform state, it does not revert the job.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the SyncIQ job.",
+				MarkdownDescription: "ID of the SyncIQ job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID or name of the SyncIQ policy to start the job for.",
+				MarkdownDescription: "The ID or name of the SyncIQ policy to start the job for.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("run"),
+				Description: "The action to be taken by the job. Acceptable values are run, resync_prep, allow_write, allow_write_revert and test." +
+					" allow_write and allow_write_revert need to be run on the target cluster of the policy.",
+				MarkdownDescription: "The action to be taken by the job. Acceptable values are run, resync_prep, allow_write, allow_write_revert and test." +
+					" allow_write and allow_write_revert need to be run on the target cluster of the policy.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("run", "resync_prep", "allow_write", "allow_write_revert", "test"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_snapshot": schema.StringAttribute{
+				Optional:            true,
+				Description:         "An optional snapshot to copy/sync from.",
+				MarkdownDescription: "An optional snapshot to copy/sync from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workers_per_node": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Specifies the desired number of workers per node. Defaults to the value configured in the policy.",
+				MarkdownDescription: "Specifies the desired number of workers per node. Defaults to the value configured in the policy.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				Description:         "Whether to wait for the job to complete. If true, the resource fails when the job fails. Defaults to true. The wait is bounded by the create timeout.",
+				MarkdownDescription: "Whether to wait for the job to complete. If true, the resource fails when the job fails. Defaults to true. The wait is bounded by the create timeout.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The last observed state of the job.",
+				MarkdownDescription: "The last observed state of the job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"report": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "The report of the job. Only populated when wait_for_completion is true.",
+				MarkdownDescription: "The report of the job. Only populated when wait_for_completion is true.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						Description:         "A unique identifier for this object.",
+						MarkdownDescription: "A unique identifier for this object.",
+					},
+					"job_id": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The ID of the job.",
+						MarkdownDescription: "The ID of the job.",
+					},
+					"policy_id": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The ID of the policy.",
+						MarkdownDescription: "The ID of the policy.",
+					},
+					"policy_name": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The name of the policy.",
+						MarkdownDescription: "The name of the policy.",
+					},
+					"action": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The action performed by the job.",
+						MarkdownDescription: "The action performed by the job.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The state of the job.",
+						MarkdownDescription: "The state of the job.",
+					},
+					"start_time": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The time the job started in unix epoch seconds.",
+						MarkdownDescription: "The time the job started in unix epoch seconds.",
+					},
+					"end_time": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The time the job ended in unix epoch seconds.",
+						MarkdownDescription: "The time the job ended in unix epoch seconds.",
+					},
+					"duration": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The amount of time in seconds between when the job was started and when it ended.",
+						MarkdownDescription: "The amount of time in seconds between when the job was started and when it ended.",
+					},
+					"total_files": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The number of files affected by the job.",
+						MarkdownDescription: "The number of files affected by the job.",
+					},
+					"files_transferred": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The number of files transferred by the job.",
+						MarkdownDescription: "The number of files transferred by the job.",
+					},
+					"bytes_transferred": schema.Int64Attribute{
+						Computed:            true,
+						Description:         "The number of bytes transferred by the job.",
+						MarkdownDescription: "The number of bytes transferred by the job.",
+					},
+					"errors": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "A list of error messages for the job.",
+						MarkdownDescription: "A list of error messages for the job.",
+					},
+					"warnings": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "A list of warning messages for the job.",
+						MarkdownDescription: "A list of warning messages for the job.",
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SyncIQJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create starts the SyncIQ job.
+func (r *SyncIQJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Starting SyncIQ job")
+	var plan models.SyncIQJobResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	state, diags := helper.RunSyncIQJob(ctx, r.client, plan)
+	resp.Diagnostics.Append(diags...)
+	if state.ID.IsUnknown() {
+		// the job was never started, nothing to save
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "SyncIQ job completed")
+}
+
+// Read reads the resource state.
+// A SyncIQ job is a one-off operation, so the state recorded when the job was run is kept.
+func (r *SyncIQJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ job")
+	var state models.SyncIQJobResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource state.
+// Only wait_for_completion and the timeouts can be updated in place, which does not start a new job.
+func (r *SyncIQJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SyncIQ job")
+	var plan, state models.SyncIQJobResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the resource from the state.
+func (r *SyncIQJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SyncIQ job resource state")
+	resp.State.RemoveResource(ctx)
+}
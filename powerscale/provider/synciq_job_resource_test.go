@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSyncIQJobResource tests the SyncIQ job resource.
+func TestAccSyncIQJobResource(t *testing.T) {
+	resourceName := "powerscale_synciq_job.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid action
+			{
+				Config: ProviderConfig + `
+				resource "powerscale_synciq_job" "test" {
+					policy_id = "tfacc_job_policy"
+					action = "invalid"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccSyncIQJobResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateSyncIQJob).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// wait error
+			{
+				Config: ProviderConfig + testAccSyncIQJobResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForSyncIQJob).Return("", nil, fmt.Errorf("mock wait error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock wait error.*`),
+			},
+			// canceled job
+			{
+				Config: ProviderConfig + testAccSyncIQJobResourceConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.WaitForSyncIQJob).Return("canceled", nil, nil).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*finished in state canceled.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSyncIQJobResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_id", "tfacc_job_policy"),
+					resource.TestCheckResourceAttr(resourceName, "action", "test"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "finished"),
+					resource.TestCheckResourceAttr(resourceName, "report.policy_name", "tfacc_job_policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// update in place
+			{
+				Config: ProviderConfig + testAccSyncIQJobResourceNoWaitConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "finished"),
+				),
+			},
+			// policy referenced by ID
+			{
+				Config: ProviderConfig + testAccSyncIQJobResourcePolicyIDConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "finished"),
+					resource.TestCheckResourceAttr(resourceName, "report.policy_name", "tfacc_job_policy"),
+					resource.TestCheckResourceAttrPair(resourceName, "report.policy_id", "powerscale_synciq_policy.policy", "id"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSyncIQJobResourceAllowWrite tests the SyncIQ job resource with an action which runs on the target cluster.
+func TestAccSyncIQJobResourceAllowWrite(t *testing.T) {
+	resourceName := "powerscale_synciq_job.test"
+	var policyMocker, reportMocker, stateMocker *mockey.Mocker
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// the policy is looked up among the target policies
+			{
+				Config: ProviderConfig + testAccSyncIQJobResourceAllowWriteConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListSyncIQTargetPolicies).Return(nil, fmt.Errorf("mock target policy error")).Build()
+					policyMocker = mockey.Mock(helper.GetSyncIQPolicyByID).Return(nil, fmt.Errorf("mock source policy error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock target policy error.*`),
+			},
+			// the report is read from the target reports
+			{
+				Config: ProviderConfig + testAccSyncIQJobResourceAllowWriteConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					policyMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetSyncIQPolicyName).When(func(ctx context.Context, client *client.Client, policy string, target bool) bool {
+						return target
+					}).Return("tfacc_target_policy", nil).Build()
+					policyMocker = mockey.Mock(helper.CreateSyncIQJob).Return("tfacc_target_policy", nil).Build()
+					stateMocker = mockey.Mock(helper.GetSyncIQJobState).Return("", int64(0), false, nil).Build()
+					calls := 0
+					reportMocker = mockey.Mock(helper.ListSyncIQJobReports).To(func(ctx context.Context, client *client.Client, policyName string, target bool, limit int32) ([]models.SyncIQJobReportModel, error) {
+						if !target {
+							return nil, fmt.Errorf("mock source report error")
+						}
+						calls++
+						report := models.SyncIQJobReportModel{
+							JobID:      types.Int64Value(int64(calls)),
+							PolicyName: types.StringValue(policyName),
+							Action:     types.StringValue("allow_write"),
+							State:      types.StringValue("finished"),
+							Errors:     types.ListNull(types.StringType),
+							Warnings:   types.ListNull(types.StringType),
+						}
+						return []models.SyncIQJobReportModel{report}, nil
+					}).Build()
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "allow_write"),
+					resource.TestCheckResourceAttr(resourceName, "state", "finished"),
+					resource.TestCheckResourceAttr(resourceName, "report.job_id", "2"),
+					resource.TestCheckResourceAttr(resourceName, "report.policy_name", "tfacc_target_policy"),
+					resource.TestCheckResourceAttr(resourceName, "report.action", "allow_write"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "5m"),
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					policyMocker.Release()
+					stateMocker.Release()
+					reportMocker.Release()
+				},
+				Config: ProviderConfig + testAccSyncIQJobResourceAllowWriteConfig,
+			},
+		},
+	})
+}
+
+var testAccSyncIQJobPolicyConfig = `
+resource "powerscale_synciq_policy" "policy" {
+	name = "tfacc_job_policy"
+	action = "sync"
+	source_root_path = "/ifs"
+	target_host = "10.10.10.10"
+	target_path = "/ifs/tfacc_job_policy"
+}
+`
+
+var testAccSyncIQJobResourceConfig = testAccSyncIQJobPolicyConfig + `
+resource "powerscale_synciq_job" "test" {
+	policy_id = powerscale_synciq_policy.policy.name
+	action = "test"
+}
+`
+
+var testAccSyncIQJobResourceNoWaitConfig = testAccSyncIQJobPolicyConfig + `
+resource "powerscale_synciq_job" "test" {
+	policy_id = powerscale_synciq_policy.policy.name
+	action = "test"
+	wait_for_completion = false
+}
+`
+
+var testAccSyncIQJobResourcePolicyIDConfig = testAccSyncIQJobPolicyConfig + `
+resource "powerscale_synciq_job" "test" {
+	policy_id = powerscale_synciq_policy.policy.id
+	action = "test"
+}
+`
+
+var testAccSyncIQJobResourceAllowWriteConfig = `
+resource "powerscale_synciq_job" "test" {
+	policy_id = "tfacc_target_policy"
+	action = "allow_write"
+	timeouts {
+		create = "5m"
+	}
+}
+`
@@ -82,6 +82,14 @@ func (s *synciqPolicyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var toUpdate powerscale.V14SyncPolicy
 	// Get param from tf input
 	err := helper.ReadFromState(ctx, &plan, &toUpdate)
@@ -106,6 +114,7 @@ func (s *synciqPolicyResource) Create(ctx context.Context, req resource.CreateRe
 
 	state, dgs := s.GetStateByID(ctx, id)
 	state.Password = plan.Password
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(dgs...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -144,6 +153,7 @@ func (s *synciqPolicyResource) Read(ctx context.Context, req resource.ReadReques
 
 	state, dgs := s.GetStateByID(ctx, oldState.ID.ValueString())
 	state.Password = oldState.Password
+	state.Timeouts = oldState.Timeouts
 	resp.Diagnostics.Append(dgs...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -167,6 +177,14 @@ func (s *synciqPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Get param from tf input
 	var toUpdate powerscale.V14SyncPolicyExtendedExtended
 	err := helper.ReadFromState(ctx, &plan, &toUpdate)
@@ -198,6 +216,7 @@ func (s *synciqPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 
 	state, dgs := s.GetStateByID(ctx, OldState.ID.ValueString())
 	state.Password = plan.Password
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(dgs...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -216,6 +235,14 @@ func (s *synciqPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helper.DefaultResourceTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := helper.DeleteSyncIQPolicy(ctx, s.client, state.ID.ValueString())
 	if err != nil {
 		errStr := "Could not delete syncIQ Policy with error: "
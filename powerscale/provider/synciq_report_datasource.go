@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SyncIQReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &SyncIQReportDataSource{}
+)
+
+// NewSyncIQReportDataSource creates a new data source.
+func NewSyncIQReportDataSource() datasource.DataSource {
+	return &SyncIQReportDataSource{}
+}
+
+// SyncIQReportDataSource defines the data source implementation.
+type SyncIQReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SyncIQReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_report"
+}
+
+// Schema describes the data source arguments.
+func (d *SyncIQReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = helper.SyncIQReportDataSourceSchema(ctx, "This datasource is used to query the reports of the SyncIQ jobs run on this PowerScale array as the source cluster. The information fetched from this datasource can be used for checking the outcome of the SyncIQ jobs of a policy.")
+}
+
+// Configure configures the data source.
+func (d *SyncIQReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SyncIQReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.SyncIQReportDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports, err := helper.ListSyncIQReports(ctx, d.client, data.Filter)
+	if err != nil {
+		errStr := constants.ListSynciqReportsMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading syncIQ reports", message)
+		return
+	}
+
+	state, err := helper.NewSyncIQReportDataSource(ctx, "synciq_report_datasource", reports)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map sync report fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
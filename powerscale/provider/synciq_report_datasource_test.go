@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSyncIQReportDataSource tests the SyncIQ report datasource.
+func TestAccSyncIQReportDataSource(t *testing.T) {
+	dataSourceName := "data.powerscale_synciq_report.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// read error
+			{
+				Config: ProviderConfig + `
+				data "powerscale_synciq_report" "test" {
+				}
+				`,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListSyncIQReports).Return(nil, fmt.Errorf("mock network error")).Build()
+				},
+				ExpectError: regexp.MustCompile("mock network error"),
+			},
+			// invalid filter
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + `
+				data "powerscale_synciq_report" "test" {
+					filter {
+						state = "invalid"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match.*`),
+			},
+			// read all
+			{
+				Config: ProviderConfig + `
+				data "powerscale_synciq_report" "test" {
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "reports.#"),
+				),
+			},
+			// read with filter
+			{
+				Config: ProviderConfig + `
+				data "powerscale_synciq_report" "test" {
+					filter {
+						policy_name = "tfacc_nonexistent_policy"
+						state = "finished"
+						sort = "end_time"
+						dir = "DESC"
+						limit = 5
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reports.#", "0"),
+				),
+			},
+		},
+	})
+}
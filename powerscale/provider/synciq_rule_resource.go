@@ -0,0 +1,297 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SyncIQRuleResource{}
+	_ resource.ResourceWithConfigure   = &SyncIQRuleResource{}
+	_ resource.ResourceWithImportState = &SyncIQRuleResource{}
+)
+
+// NewSyncIQRuleResource creates a new resource.
+func NewSyncIQRuleResource() resource.Resource {
+	return &SyncIQRuleResource{}
+}
+
+// SyncIQRuleResource defines the resource implementation.
+type SyncIQRuleResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *SyncIQRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_rule"
+}
+
+// Schema describes the resource arguments.
+func (r *SyncIQRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	timeValidators := []validator.String{
+		stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be in the format hh:mm"),
+	}
+	dayAttribute := func(day string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         fmt.Sprintf("If true, this rule is in effect on %s.  If false, or unspecified, it is not.", day),
+			MarkdownDescription: fmt.Sprintf("If true, this rule is in effect on %s.  If false, or unspecified, it is not.", day),
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the SyncIQ Replication Rule entity of PowerScale Array. " +
+			"We can Create, Read, Update and Delete the SyncIQ Replication Rule using this resource. We can also import existing SyncIQ Replication Rule from PowerScale array. " +
+			"SyncIQ Replication Rules are used to throttle the bandwidth, file count, CPU or worker usage of SyncIQ jobs.",
+		Description: "This resource is used to manage the SyncIQ Replication Rule entity of PowerScale Array. " +
+			"We can Create, Read, Update and Delete the SyncIQ Replication Rule using this resource. We can also import existing SyncIQ Replication Rule from PowerScale array. " +
+			"SyncIQ Replication Rules are used to throttle the bandwidth, file count, CPU or worker usage of SyncIQ jobs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to this performance rule.",
+				MarkdownDescription: "The system ID given to this performance rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Description: "The type of system resource this rule limits." +
+					" This resource will be recreated if the value of this field is changed.",
+				MarkdownDescription: "The type of system resource this rule limits." +
+					" This resource will be recreated if the value of this field is changed.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"bandwidth",
+						"file_count",
+						"cpu",
+						"worker",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "User-entered description of this performance rule.",
+				MarkdownDescription: "User-entered description of this performance rule.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether this performance rule is currently in effect during its specified intervals.",
+				MarkdownDescription: "Whether this performance rule is currently in effect during its specified intervals.",
+			},
+			"limit": schema.Int64Attribute{
+				Required:            true,
+				Description:         "Amount the specified system resource type is limited by this rule.  Units are kb/s for bandwidth, files/s for file-count, processing percentage used for cpu, or percentage of maximum available workers.",
+				MarkdownDescription: "Amount the specified system resource type is limited by this rule.  Units are kb/s for bandwidth, files/s for file-count, processing percentage used for cpu, or percentage of maximum available workers.",
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "A schedule defining when during a week this performance rule is in effect.  If unspecified or null, the schedule will always be in effect.",
+				MarkdownDescription: "A schedule defining when during a week this performance rule is in effect.  If unspecified or null, the schedule will always be in effect.",
+				Attributes: map[string]schema.Attribute{
+					"begin": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "Start time (inclusive) for this schedule, during its specified days.  Format is \"hh:mm\" (24h format hour, and minute).  A null value indicates the beginning of the day (\"00:00\").",
+						MarkdownDescription: "Start time (inclusive) for this schedule, during its specified days.  Format is \"hh:mm\" (24h format hour, and minute).  A null value indicates the beginning of the day (\"00:00\").",
+						Validators:          timeValidators,
+					},
+					"end": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "End time (inclusive) for this schedule, during its specified days.  Format is \"hh:mm\" (24h format hour, and minute).  A null value indicates the end of the day (\"23:59\").",
+						MarkdownDescription: "End time (inclusive) for this schedule, during its specified days.  Format is \"hh:mm\" (24h format hour, and minute).  A null value indicates the end of the day (\"23:59\").",
+						Validators:          timeValidators,
+					},
+					"monday":    dayAttribute("Monday"),
+					"tuesday":   dayAttribute("Tuesday"),
+					"wednesday": dayAttribute("Wednesday"),
+					"thursday":  dayAttribute("Thursday"),
+					"friday":    dayAttribute("Friday"),
+					"saturday":  dayAttribute("Saturday"),
+					"sunday":    dayAttribute("Sunday"),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SyncIQRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *SyncIQRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SyncIQ rule")
+	var plan models.SyncIQRuleResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleToCreate powerscale.V3SyncRule
+	if err := helper.ReadFromState(ctx, &plan, &ruleToCreate); err != nil {
+		resp.Diagnostics.AddError("Error creating SyncIQ rule",
+			fmt.Sprintf("Could not read SyncIQ rule param with error: %s", err.Error()))
+		return
+	}
+
+	id, err := helper.CreateSyncIQRule(ctx, r.client, ruleToCreate)
+	if err != nil {
+		errStr := constants.CreateSynciqRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating SyncIQ rule", message)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("SyncIQ rule %s created", id))
+
+	state := plan
+	if err := helper.GetSyncIQRuleResourceState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadSynciqRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SyncIQ rule after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create SyncIQ rule completed")
+}
+
+// Read reads the resource state.
+func (r *SyncIQRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SyncIQ rule")
+	var state models.SyncIQRuleResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetSyncIQRuleResourceState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadSynciqRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SyncIQ rule", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read SyncIQ rule completed")
+}
+
+// Update updates the resource state.
+func (r *SyncIQRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SyncIQ rule")
+	var plan, state models.SyncIQRuleResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	var ruleToUpdate powerscale.V3SyncRuleExtendedExtended
+	if err := helper.ReadFromState(ctx, &plan, &ruleToUpdate); err != nil {
+		resp.Diagnostics.AddError("Error updating SyncIQ rule",
+			fmt.Sprintf("Could not read SyncIQ rule %s param with error: %s", id, err.Error()))
+		return
+	}
+
+	if err := helper.UpdateSyncIQRule(ctx, r.client, id, ruleToUpdate); err != nil {
+		errStr := constants.UpdateSynciqRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating SyncIQ rule", message)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := helper.GetSyncIQRuleResourceState(ctx, r.client, id, &plan); err != nil {
+		errStr := constants.ReadSynciqRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading SyncIQ rule after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Update SyncIQ rule completed")
+}
+
+// Delete deletes the resource.
+func (r *SyncIQRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SyncIQ rule")
+	var state models.SyncIQRuleResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteSyncIQRule(ctx, r.client, state.ID.ValueString()); err != nil {
+		errStr := constants.DeleteSynciqRuleErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error deleting SyncIQ rule", message)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete SyncIQ rule completed")
+}
+
+// ImportState imports the resource state.
+func (r *SyncIQRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
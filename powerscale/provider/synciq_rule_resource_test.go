@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSyncIQRuleResource tests the SyncIQ rule resource.
+func TestAccSyncIQRuleResource(t *testing.T) {
+	resourceName := "powerscale_synciq_rule.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid schedule
+			{
+				Config: ProviderConfig + `
+				resource "powerscale_synciq_rule" "test" {
+					type = "bandwidth"
+					limit = 10000
+					schedule = {
+						begin = "25:00"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`.*must be in the format hh:mm.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + testAccSyncIQRuleResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateSyncIQRule).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSyncIQRuleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "bandwidth"),
+					resource.TestCheckResourceAttr(resourceName, "limit", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.begin", "08:00"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.monday", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update error
+			{
+				Config: ProviderConfig + testAccSyncIQRuleResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateSyncIQRule).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// update
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSyncIQRuleResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "limit", "20000"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "tfacc rule updated"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.saturday", "true"),
+				),
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccSyncIQRuleResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetSyncIQRuleResourceState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// delete error
+			{
+				Config: ProviderConfig + testAccSyncIQRuleResourceUpdateConfig,
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteSyncIQRule).Return(fmt.Errorf("mock delete error")).Build()
+				},
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*mock delete error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccSyncIQRuleResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var testAccSyncIQRuleResourceConfig = `
+resource "powerscale_synciq_rule" "test" {
+	type = "bandwidth"
+	limit = 10000
+	description = "tfacc rule"
+	enabled = true
+	schedule = {
+		begin = "08:00"
+		end = "18:00"
+		monday = true
+		tuesday = true
+		wednesday = true
+		thursday = true
+		friday = true
+		saturday = false
+		sunday = false
+	}
+}
+`
+
+var testAccSyncIQRuleResourceUpdateConfig = `
+resource "powerscale_synciq_rule" "test" {
+	type = "bandwidth"
+	limit = 20000
+	description = "tfacc rule updated"
+	enabled = false
+	schedule = {
+		begin = "00:00"
+		end = "23:59"
+		monday = false
+		tuesday = false
+		wednesday = false
+		thursday = false
+		friday = false
+		saturday = true
+		sunday = true
+	}
+}
+`
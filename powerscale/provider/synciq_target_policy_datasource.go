@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SyncIQTargetPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &SyncIQTargetPolicyDataSource{}
+)
+
+// NewSyncIQTargetPolicyDataSource creates a new SyncIQ target policy data source.
+func NewSyncIQTargetPolicyDataSource() datasource.DataSource {
+	return &SyncIQTargetPolicyDataSource{}
+}
+
+// SyncIQTargetPolicyDataSource defines the data source implementation.
+type SyncIQTargetPolicyDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *SyncIQTargetPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_target_policy"
+}
+
+// Schema describes the data source arguments.
+func (d *SyncIQTargetPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This datasource is used to query the SyncIQ policies which replicate to this PowerScale array, as seen from the target side." +
+			" The information fetched from this datasource can be used to check the failover/failback state of the policies on the target cluster.",
+		Description: "This datasource is used to query the SyncIQ policies which replicate to this PowerScale array, as seen from the target side." +
+			" The information fetched from this datasource can be used to check the failover/failback state of the policies on the target cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Identifier of the datasource.",
+				MarkdownDescription: "Identifier of the datasource.",
+			},
+			"target_policies": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of SyncIQ target policies.",
+				MarkdownDescription: "List of SyncIQ target policies.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The system ID given to this policy.",
+							MarkdownDescription: "The system ID given to this policy.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "User-assigned name of the policy.",
+							MarkdownDescription: "User-assigned name of the policy.",
+						},
+						"source_cluster_guid": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The GUID of the source cluster.",
+							MarkdownDescription: "The GUID of the source cluster.",
+						},
+						"source_host": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Hostname or IP address of the sync source cluster.",
+							MarkdownDescription: "Hostname or IP address of the sync source cluster.",
+						},
+						"target_path": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Absolute filesystem path on the target cluster for the sync destination.",
+							MarkdownDescription: "Absolute filesystem path on the target cluster for the sync destination.",
+						},
+						"failover_failback_state": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The state of the policy with respect to SyncIQ failover/failback.",
+							MarkdownDescription: "The state of the policy with respect to SyncIQ failover/failback.",
+						},
+						"last_job_state": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The state of the most recent job on this policy.",
+							MarkdownDescription: "The state of the most recent job on this policy.",
+						},
+						"last_source_coordinator_ip": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The IP address of the last source cluster coordinator.",
+							MarkdownDescription: "The IP address of the last source cluster coordinator.",
+						},
+						"last_update_from_source": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The time of the last update from the source cluster in unix epoch seconds.",
+							MarkdownDescription: "The time of the last update from the source cluster in unix epoch seconds.",
+						},
+						"legacy_policy": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "True if this policy is a legacy policy.",
+							MarkdownDescription: "True if this policy is a legacy policy.",
+						},
+						"target_snapshot_alias": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The alias of the snapshot taken on the target cluster after the sync completes.",
+							MarkdownDescription: "The alias of the snapshot taken on the target cluster after the sync completes.",
+						},
+						"target_snapshot_archive": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "If true, archival snapshots of the target data are taken on the target cluster.",
+							MarkdownDescription: "If true, archival snapshots of the target data are taken on the target cluster.",
+						},
+						"target_snapshot_expiration": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of seconds after which archival snapshots on the target cluster expire.",
+							MarkdownDescription: "The number of seconds after which archival snapshots on the target cluster expire.",
+						},
+						"target_snapshot_pattern": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name pattern for archival snapshots taken on the target cluster.",
+							MarkdownDescription: "The name pattern for archival snapshots taken on the target cluster.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Description:         "Filters for fetching SyncIQ target policies.",
+				MarkdownDescription: "Filters for fetching SyncIQ target policies.",
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Names of the SyncIQ target policies to be fetched.",
+						MarkdownDescription: "Names of the SyncIQ target policies to be fetched.",
+					},
+					"target_path": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list target policies which replicate into this path.",
+						MarkdownDescription: "Only list target policies which replicate into this path.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"last_job_state": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list target policies whose most recent job is in this state.",
+						MarkdownDescription: "Only list target policies whose most recent job is in this state.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"scheduled",
+								"running",
+								"paused",
+								"finished",
+								"failed",
+								"canceled",
+								"needs_attention",
+								"skipped",
+								"pending",
+								"unknown",
+							),
+						},
+					},
+					"failover_failback_state": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Only list target policies in this failover/failback state.",
+						MarkdownDescription: "Only list target policies in this failover/failback state.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"writes_disabled",
+								"enabling_writes",
+								"writes_enabled",
+								"disabling_writes",
+								"creating_resync_policy",
+								"resync_policy_created",
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *SyncIQTargetPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *SyncIQTargetPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data models.SyncIQTargetPolicyDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := helper.ListSyncIQTargetPolicies(ctx, d.client, data.Filter)
+	if err != nil {
+		errStr := constants.ListSynciqTargetPoliciesMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading syncIQ target policies", message)
+		return
+	}
+	policies = helper.FilterSyncIQTargetPolicies(policies, data.Filter)
+
+	state, err := helper.NewSyncIQTargetPolicyDataSource(ctx, policies)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to map sync target policy fields", err.Error())
+		return
+	}
+	state.Filter = data.Filter
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
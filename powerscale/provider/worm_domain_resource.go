@@ -0,0 +1,390 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &WormDomainResource{}
+	_ resource.ResourceWithConfigure      = &WormDomainResource{}
+	_ resource.ResourceWithImportState    = &WormDomainResource{}
+	_ resource.ResourceWithModifyPlan     = &WormDomainResource{}
+	_ resource.ResourceWithValidateConfig = &WormDomainResource{}
+)
+
+// NewWormDomainResource creates a new resource.
+func NewWormDomainResource() resource.Resource {
+	return &WormDomainResource{}
+}
+
+// WormDomainResource defines the resource implementation.
+type WormDomainResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *WormDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_domain"
+}
+
+// wormRetentionAttribute returns the schema of a retention of the WORM domain, validated against the given regex.
+func wormRetentionAttribute(description string, retentionRegex *regexp.Regexp, message string) schema.StringAttribute {
+	description += " Either forever, or a period such as 1Y6M using the units Y, M, W, D, H, m and s."
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(retentionRegex, message),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// Schema describes the resource arguments.
+func (r *WormDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the SmartLock WORM domains on PowerScale Array. We can Create and Update the WORM domains using this resource. " +
+			"We can also import an existing WORM domain from PowerScale array. " +
+			"A WORM domain cannot be deleted from the array, destroying the resource only removes it from the state. " +
+			"The path and the type of a domain cannot be changed, and a privileged delete which is disabled cannot be enabled again.",
+		Description: "This resource is used to manage the SmartLock WORM domains on PowerScale Array. We can Create and Update the WORM domains using this resource. " +
+			"We can also import an existing WORM domain from PowerScale array. " +
+			"A WORM domain cannot be deleted from the array, destroying the resource only removes it from the state. " +
+			"The path and the type of a domain cannot be changed, and a privileged delete which is disabled cannot be enabled again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The system ID given to the domain.",
+				MarkdownDescription: "The system ID given to the domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				Description:         "The root path of the domain. Cannot be changed once the domain is created.",
+				MarkdownDescription: "The root path of the domain. Cannot be changed once the domain is created.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("enterprise"),
+				Description:         "The type of the domain, enterprise or compliance. A compliance domain requires a cluster in compliance mode. Cannot be changed once the domain is created.",
+				MarkdownDescription: "The type of the domain, `enterprise` or `compliance`. A compliance domain requires a cluster in compliance mode. Cannot be changed once the domain is created.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("enterprise", "compliance"),
+				},
+			},
+			"autocommit_offset": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The autocommit time period in seconds, after which a file which is not modified is committed to the WORM state.",
+				MarkdownDescription: "The autocommit time period in seconds, after which a file which is not modified is committed to the WORM state.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_retention": wormRetentionAttribute("The retention given to a committed file when none is set on it. Can also be use_min or use_max.",
+				helper.WormDefaultRetentionRegex, "must be forever, use_min, use_max or a period such as 1Y6M"),
+			"min_retention": wormRetentionAttribute("The minimum retention of the files of the domain.",
+				helper.WormRetentionRegex, "must be forever or a period such as 1Y6M"),
+			"max_retention": wormRetentionAttribute("The maximum retention of the files of the domain.",
+				helper.WormRetentionRegex, "must be forever or a period such as 1Y6M"),
+			"override_date": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The override retention date of the domain in unix epoch seconds. The files of the domain are retained at least until this date.",
+				MarkdownDescription: "The override retention date of the domain in unix epoch seconds. The files of the domain are retained at least until this date.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"privileged_delete": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The privileged delete state of the domain, on, off or disabled. A disabled privileged delete cannot be enabled again.",
+				MarkdownDescription: "The privileged delete state of the domain, `on`, `off` or `disabled`. A disabled privileged delete cannot be enabled again.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off", "disabled"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"lin": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The LIN of the root directory of the domain.",
+				MarkdownDescription: "The LIN of the root directory of the domain.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *WormDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ValidateConfig checks that the retentions of the domain are consistent.
+func (r *WormDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, retention := range []struct {
+		name  string
+		value types.String
+	}{
+		{"default_retention", config.DefaultRetention},
+		{"min_retention", config.MinRetention},
+		{"max_retention", config.MaxRetention},
+	} {
+		if retention.value.IsNull() || retention.value.IsUnknown() {
+			continue
+		}
+		if _, err := helper.ParseWormRetention(retention.value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(retention.name), "Invalid WORM domain retention", err.Error())
+		}
+	}
+
+	minRetention, minOk := helper.WormRetentionSeconds(config.MinRetention.ValueString())
+	maxRetention, maxOk := helper.WormRetentionSeconds(config.MaxRetention.ValueString())
+	defaultRetention, defaultOk := helper.WormRetentionSeconds(config.DefaultRetention.ValueString())
+	if minOk && maxOk && minRetention > maxRetention {
+		resp.Diagnostics.AddAttributeError(path.Root("min_retention"), "Invalid WORM domain retention", "min_retention must not be longer than max_retention")
+	}
+	if minOk && defaultOk && defaultRetention < minRetention {
+		resp.Diagnostics.AddAttributeError(path.Root("default_retention"), "Invalid WORM domain retention", "default_retention must not be shorter than min_retention")
+	}
+	if maxOk && defaultOk && defaultRetention > maxRetention {
+		resp.Diagnostics.AddAttributeError(path.Root("default_retention"), "Invalid WORM domain retention", "default_retention must not be longer than max_retention")
+	}
+}
+
+// ModifyPlan guards the changes which cannot be made, or cannot be reverted, on a WORM domain.
+func (r *WormDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning("WORM domain is not deleted",
+			"A WORM domain cannot be deleted from the PowerScale array, destroying the resource only removes it from the state.")
+		return
+	}
+	var plan models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if plan.Type.ValueString() == "compliance" {
+			resp.Diagnostics.AddWarning("Compliance WORM domain is irreversible",
+				fmt.Sprintf("The compliance WORM domain on %s cannot be removed, and the files committed to it cannot be deleted before their retention expires.", plan.Path.ValueString()))
+		}
+		return
+	}
+	var state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Path.Equal(state.Path) {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "WORM domain path cannot be changed",
+			fmt.Sprintf("The path of the WORM domain %s cannot be changed from %s.", state.ID.ValueString(), state.Path.ValueString()))
+	}
+	if !plan.Type.Equal(state.Type) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "WORM domain type cannot be changed",
+			fmt.Sprintf("The type of the WORM domain %s cannot be changed from %s.", state.ID.ValueString(), state.Type.ValueString()))
+	}
+	if state.PrivilegedDelete.ValueString() == "disabled" && !plan.PrivilegedDelete.IsUnknown() && plan.PrivilegedDelete.ValueString() != "disabled" {
+		resp.Diagnostics.AddAttributeError(path.Root("privileged_delete"), "WORM domain privileged delete cannot be enabled",
+			"The privileged delete of the WORM domain is permanently disabled.")
+	} else if state.PrivilegedDelete.ValueString() != "disabled" && plan.PrivilegedDelete.ValueString() == "disabled" {
+		resp.Diagnostics.AddWarning("WORM domain privileged delete is disabled permanently",
+			"Once disabled, the privileged delete of the WORM domain cannot be enabled again.")
+	}
+	if state.Type.ValueString() == "compliance" {
+		// the files of a compliance domain must never be retained for less time than they were
+		for _, retention := range []struct {
+			name  string
+			plan  string
+			state string
+		}{
+			{"min_retention", plan.MinRetention.ValueString(), state.MinRetention.ValueString()},
+			{"default_retention", plan.DefaultRetention.ValueString(), state.DefaultRetention.ValueString()},
+		} {
+			planned, plannedOk := helper.WormRetentionSeconds(retention.plan)
+			current, currentOk := helper.WormRetentionSeconds(retention.state)
+			if (plannedOk && currentOk && planned < current) || (retention.state == "forever" && retention.plan != "" && retention.plan != "forever") {
+				resp.Diagnostics.AddAttributeError(path.Root(retention.name), "WORM domain retention cannot be shortened",
+					fmt.Sprintf("The %s of the compliance WORM domain cannot be shortened from %s to %s.", retention.name, retention.state, retention.plan))
+			}
+		}
+	}
+}
+
+// Create creates the WORM domain.
+func (r *WormDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating WORM domain")
+	var plan models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateWormDomain(ctx, r.client, &plan)
+	if err != nil {
+		errStr := constants.CreateWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating WORM domain", message)
+		return
+	}
+
+	var state models.WormDomainResourceModel
+	if err := helper.GetWormDomainState(ctx, r.client, id, &state); err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading WORM domain after create", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create WORM domain completed")
+}
+
+// Read reads the resource state.
+func (r *WormDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading WORM domain")
+	var state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetWormDomainState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading WORM domain", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read WORM domain completed")
+}
+
+// Update updates the WORM domain.
+func (r *WormDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating WORM domain")
+	var plan, state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateWormDomain(ctx, r.client, state.ID.ValueString(), &plan, &state); err != nil {
+		errStr := constants.UpdateWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating WORM domain", message)
+		return
+	}
+
+	if err := helper.GetWormDomainState(ctx, r.client, state.ID.ValueString(), &state); err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading WORM domain after update", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Update WORM domain completed")
+}
+
+// Delete removes the WORM domain from the state, as a WORM domain cannot be deleted from the array.
+func (r *WormDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing WORM domain from the state")
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the resource state.
+func (r *WormDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state models.WormDomainResourceModel
+	if err := helper.GetWormDomainState(ctx, r.client, req.ID, &state); err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing WORM domain", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccWormDomainResource tests the WORM domain resource.
+func TestAccWormDomainResource(t *testing.T) {
+	resourceName := "powerscale_worm_domain.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid retention
+			{
+				Config:      ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 3600, "1X", "1Y"),
+				ExpectError: regexp.MustCompile(`.*must be forever or a period.*`),
+			},
+			// use_min is only valid as the default retention
+			{
+				Config:      ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 3600, "use_min", "1Y"),
+				ExpectError: regexp.MustCompile(`.*must be forever or a period.*`),
+			},
+			// repeated retention unit
+			{
+				Config:      ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 3600, "1D1D", "1Y"),
+				ExpectError: regexp.MustCompile(`.*the unit D is repeated.*`),
+			},
+			// min retention longer than max retention
+			{
+				Config:      ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 3600, "2Y", "1Y"),
+				ExpectError: regexp.MustCompile(`.*min_retention must not be longer than max_retention.*`),
+			},
+			// create error
+			{
+				Config: ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 3600, "1D", "1Y"),
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateWormDomain).Return("", fmt.Errorf("mock create error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock create error.*`),
+			},
+			// create
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 3600, "1D", "1Y"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "enterprise"),
+					resource.TestCheckResourceAttr(resourceName, "autocommit_offset", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "min_retention", "1D"),
+					resource.TestCheckResourceAttr(resourceName, "max_retention", "1Y"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update
+			{
+				Config: ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 7200, "2D", "2Y"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "autocommit_offset", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "min_retention", "2D"),
+					resource.TestCheckResourceAttr(resourceName, "max_retention", "2Y"),
+				),
+			},
+			// path cannot be changed
+			{
+				Config:      ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomainMoved", 7200, "2D", "2Y"),
+				ExpectError: regexp.MustCompile(`.*WORM domain path cannot be changed.*`),
+			},
+			// update error
+			{
+				Config: ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 3600, "2D", "2Y"),
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateWormDomain).Return(fmt.Errorf("mock update error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock update error.*`),
+			},
+			// read error
+			{
+				Config: ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 7200, "2D", "2Y"),
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetWormDomainState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + fmt.Sprintf(testAccWormDomainResourceConfig, "/ifs/tfaccWormDomain", 7200, "2D", "2Y"),
+			},
+		},
+	})
+}
+
+var testAccWormDomainResourceConfig = `
+resource "powerscale_worm_domain" "test" {
+	path = "%s"
+	autocommit_offset = %d
+	min_retention = "%s"
+	max_retention = "%s"
+}
+`
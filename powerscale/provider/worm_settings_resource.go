@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &WormSettingsResource{}
+	_ resource.ResourceWithConfigure   = &WormSettingsResource{}
+	_ resource.ResourceWithImportState = &WormSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &WormSettingsResource{}
+)
+
+// NewWormSettingsResource creates a new resource.
+func NewWormSettingsResource() resource.Resource {
+	return &WormSettingsResource{}
+}
+
+// WormSettingsResource defines the resource implementation.
+type WormSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *WormSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *WormSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the SmartLock WORM settings entity of PowerScale Array. We can Create, Update and Delete the WORM settings using this resource. " +
+			"We can also import the existing WORM settings from PowerScale array. PowerScale WORM settings provide the compliance clock of the cluster, " +
+			"which can only be set once. Deleting the resource only removes it from the state.",
+		Description: "This resource is used to manage the SmartLock WORM settings entity of PowerScale Array. We can Create, Update and Delete the WORM settings using this resource. " +
+			"We can also import the existing WORM settings from PowerScale array. PowerScale WORM settings provide the compliance clock of the cluster, " +
+			"which can only be set once. Deleting the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ID of the WORM settings.",
+				MarkdownDescription: "ID of the WORM settings.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"set_compliance_clock": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Set the compliance clock to the current time of the cluster. The compliance clock can only be set once and cannot be unset.",
+				MarkdownDescription: "Set the compliance clock to the current time of the cluster. The compliance clock can only be set once and cannot be unset.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compliance_clock_time": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The current time of the compliance clock in unix epoch seconds, when it is set.",
+				MarkdownDescription: "The current time of the compliance clock in unix epoch seconds, when it is set.",
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *WormSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ModifyPlan guards the compliance clock, which cannot be unset once it is set.
+func (r *WormSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.WormSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state models.WormSettingsResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state.SetComplianceClock.ValueBool() && !plan.SetComplianceClock.IsUnknown() && !plan.SetComplianceClock.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("set_compliance_clock"), "Compliance clock cannot be unset",
+			"The compliance clock of the cluster is already set, and cannot be unset.")
+		return
+	}
+	if !state.SetComplianceClock.ValueBool() && plan.SetComplianceClock.ValueBool() {
+		resp.Diagnostics.AddWarning("Compliance clock is set permanently",
+			"Once set, the compliance clock of the cluster cannot be unset or set again.")
+	}
+}
+
+// Create sets the compliance clock when it is planned.
+func (r *WormSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating WORM settings")
+	var plan models.WormSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.WormSettingsResourceModel
+	if err := helper.UpdateWormSettings(ctx, r.client, &plan, &state); err != nil {
+		errStr := constants.UpdateWormSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error creating WORM settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Create WORM settings completed")
+}
+
+// Read reads the resource state.
+func (r *WormSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading WORM settings")
+	var state models.WormSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.GetWormSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadWormSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading WORM settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Read WORM settings completed")
+}
+
+// Update sets the compliance clock when it is planned.
+func (r *WormSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating WORM settings")
+	var plan models.WormSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.WormSettingsResourceModel
+	if err := helper.UpdateWormSettings(ctx, r.client, &plan, &state); err != nil {
+		errStr := constants.UpdateWormSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error updating WORM settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Update WORM settings completed")
+}
+
+// Delete removes the WORM settings from the state.
+func (r *WormSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting WORM settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Delete WORM settings completed")
+}
+
+// ImportState imports the resource state.
+func (r *WormSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state models.WormSettingsResourceModel
+	if err := helper.GetWormSettingsState(ctx, r.client, &state); err != nil {
+		errStr := constants.ReadWormSettingsErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error importing WORM settings", message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
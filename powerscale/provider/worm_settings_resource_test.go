@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccWormSettingsResource tests the WORM settings resource.
+func TestAccWormSettingsResource(t *testing.T) {
+	resourceName := "powerscale_worm_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				Config: ProviderConfig + testAccWormSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetWormSettingsState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			// create without changing the compliance clock
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccWormSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "worm_settings"),
+					resource.TestCheckResourceAttrSet(resourceName, "set_compliance_clock"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compliance_clock_time"},
+			},
+			// read error
+			{
+				Config: ProviderConfig + testAccWormSettingsResourceConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetWormSettingsState).Return(fmt.Errorf("mock read error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock read error.*`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + testAccWormSettingsResourceConfig,
+			},
+		},
+	})
+}
+
+// TestAccWormSettingsResourceComplianceClock tests the request setting the compliance clock.
+// The clock of a cluster can never be reset, so the request is captured instead of being sent.
+func TestAccWormSettingsResourceComplianceClock(t *testing.T) {
+	resourceName := "powerscale_worm_settings.test"
+	var requestMocker *mockey.Mocker
+	var method, requestPath string
+	var body []byte
+	clockSet := false
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + testAccWormSettingsResourceClockConfig,
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetWormSettingsState).To(func(ctx context.Context, client *client.Client, state *models.WormSettingsResourceModel) error {
+						state.ID = types.StringValue("worm_settings")
+						state.SetComplianceClock = types.BoolValue(clockSet)
+						state.ComplianceClockTime = types.Int64Null()
+						if clockSet {
+							state.ComplianceClockTime = types.Int64Value(1700000000)
+						}
+						return nil
+					}).Build()
+					requestMocker = mockey.Mock((*client.Client).SendJSONRequest).To(func(c *client.Client, ctx context.Context, m, p string, b interface{}) error {
+						method, requestPath = m, p
+						body, _ = json.Marshal(b)
+						clockSet = true
+						return nil
+					}).Build()
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "set_compliance_clock", "true"),
+					resource.TestCheckResourceAttr(resourceName, "compliance_clock_time", "1700000000"),
+					func(*terraform.State) error {
+						// PUT /platform/1/worm/settings with an empty object as cdate sets the clock to the current system time
+						if method != http.MethodPut || requestPath != "platform/1/worm/settings" || string(body) != `{"cdate":{}}` {
+							return fmt.Errorf("unexpected compliance clock request %s %s %s", method, requestPath, string(body))
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					requestMocker.Release()
+				},
+				Config: ProviderConfig + testAccWormSettingsResourceConfig,
+			},
+		},
+	})
+}
+
+var testAccWormSettingsResourceConfig = `
+resource "powerscale_worm_settings" "test" {
+}
+`
+
+var testAccWormSettingsResourceClockConfig = `
+resource "powerscale_worm_settings" "test" {
+	set_compliance_clock = true
+}
+`